@@ -0,0 +1,128 @@
+// Copyright 2022 Sanha Maeng, Soyang Baek, Jinmyeong Kim
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 		http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package channel provides the features of the Kakao Talk Channel API.
+package channel
+
+import (
+	"context"
+	"internal/common"
+	"net/http"
+	"net/url"
+
+	"github.com/goccy/go-json"
+)
+
+// Relation represents the relation between a user and a Kakao Talk Channel.
+type Relation string
+
+const (
+	// RelationAdded means the user added the channel.
+	RelationAdded Relation = "ADDED"
+	// RelationNone means the user has not added the channel, or removed it.
+	RelationNone Relation = "NONE"
+	// RelationBlocked means the user blocked the channel.
+	RelationBlocked Relation = "BLOCKED"
+)
+
+// ChannelRelation represents the relation between the user and a channel.
+type ChannelRelation struct {
+	UUID      string   `json:"channel_uuid"`
+	PublicID  string   `json:"channel_public_id"`
+	Relation  Relation `json:"relation"`
+	CreatedAt string   `json:"created_at,omitempty"`
+	UpdatedAt string   `json:"updated_at,omitempty"`
+}
+
+// RelationsResult represents a channel relation check result.
+type RelationsResult struct {
+	UserID   int64             `json:"user_id"`
+	Channels []ChannelRelation `json:"channels"`
+}
+
+// String implements fmt.Stringer.
+func (rr RelationsResult) String() string { return common.String(rr) }
+
+// SaveAs saves rr to @filename.
+//
+// The file extension could be either .json or .xml.
+func (rr RelationsResult) SaveAs(filename string) error { return common.SaveAsJSONorXML(rr, filename) }
+
+// Of returns the relation with the channel of @publicID, which is NONE if the channel is not in rr.
+func (rr RelationsResult) Of(publicID string) Relation {
+	for _, channel := range rr.Channels {
+		if channel.PublicID == publicID {
+			return channel.Relation
+		}
+	}
+	return RelationNone
+}
+
+// TokenSource supplies the access tokens of a user, refreshing them as needed.
+type TokenSource interface {
+	Token() (string, error)
+}
+
+// StaticToken is a TokenSource which always supplies the same access token.
+type StaticToken string
+
+// Token implements TokenSource.
+func (st StaticToken) Token() (string, error) { return string(st), nil }
+
+// Relations checks the relations between the user of the access token @token and the channels of @publicIDs.
+//
+// The relations with all the channels of the app are returned if no @publicIDs are given.
+//
+// See https://developers.kakao.com/docs/latest/ko/kakaotalk-channel/rest-api#check-relationship for more details.
+func Relations(ctx context.Context, token string, publicIDs ...string) (RelationsResult, error) {
+	return RelationsWith(ctx, StaticToken(token), publicIDs...)
+}
+
+// RelationsWith is like Relations, but takes the access token from @source.
+func RelationsWith(ctx context.Context, source TokenSource, publicIDs ...string) (res RelationsResult, err error) {
+	defer common.WrapError(OpRelations, &err)
+
+	token, err := source.Token()
+	if err != nil {
+		return
+	}
+
+	endpoint := "https://kapi.kakao.com/v1/api/talk/channels"
+	if 0 < len(publicIDs) {
+		ids, err := json.Marshal(publicIDs)
+		if err != nil {
+			return res, err
+		}
+		endpoint += "?" + url.Values{"channel_public_ids": {string(ids)}}.Encode()
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return
+	}
+
+	req.Close = true
+	req.Header.Set(common.Authorization, common.FormatToken(token))
+
+	resp, err := common.Do(Service, req)
+	if err != nil {
+		return
+	}
+
+	defer resp.Body.Close()
+
+	err = json.NewDecoder(resp.Body).Decode(&res)
+
+	return
+}
@@ -0,0 +1,103 @@
+// Copyright 2022 Sanha Maeng, Soyang Baek, Jinmyeong Kim
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 		http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package channel_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"internal/common"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/maengsanha/kakao-developers-client/channel"
+	"github.com/maengsanha/kakao-developers-client/internal/mock"
+)
+
+const testToken = "Xq3vYb_0aFzE-9kTn2LdPw7RsUc4HmJgKe1BoAi6Nt8Vy5WxZl"
+
+type tokenSource struct{ calls int }
+
+func (ts *tokenSource) Token() (string, error) {
+	ts.calls++
+	return testToken, nil
+}
+
+func TestRelations(t *testing.T) {
+	var query, auth string
+	mock.Kakao(t, func(w http.ResponseWriter, r *http.Request) {
+		query, auth = r.URL.Query().Get("channel_public_ids"), r.Header.Get(common.Authorization)
+		fmt.Fprint(w, `{"user_id":1234,"channels":[
+			{"channel_uuid":"@added","channel_public_id":"_added","relation":"ADDED","created_at":"2022-01-01T00:00:00Z"},
+			{"channel_uuid":"@none","channel_public_id":"_none","relation":"NONE"},
+			{"channel_uuid":"@blocked","channel_public_id":"_blocked","relation":"BLOCKED"}]}`)
+	})
+
+	res, err := channel.Relations(context.Background(), testToken, "_added", "_none", "_blocked")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if want := `["_added","_none","_blocked"]`; query != want {
+		t.Errorf("got channel_public_ids %s, want %s", query, want)
+	}
+	if want := common.TokenPrefix + testToken; auth != want {
+		t.Errorf("got authorization %s, want %s", auth, want)
+	}
+
+	for publicID, want := range map[string]channel.Relation{
+		"_added":   channel.RelationAdded,
+		"_none":    channel.RelationNone,
+		"_blocked": channel.RelationBlocked,
+		"_unknown": channel.RelationNone,
+	} {
+		if got := res.Of(publicID); got != want {
+			t.Errorf("got %s for %s, want %s", got, publicID, want)
+		}
+	}
+	if res.UserID != 1234 || res.Channels[0].CreatedAt != "2022-01-01T00:00:00Z" {
+		t.Errorf("got %v", res)
+	}
+}
+
+func TestRelationsWith(t *testing.T) {
+	var query string
+	mock.Kakao(t, func(w http.ResponseWriter, r *http.Request) {
+		query = r.URL.RawQuery
+		fmt.Fprint(w, `{"user_id":1234,"channels":[]}`)
+	})
+
+	source := &tokenSource{}
+	if _, err := channel.RelationsWith(context.Background(), source); err != nil {
+		t.Fatal(err)
+	}
+	if source.calls != 1 || query != "" {
+		t.Errorf("got %d token calls and query %q, want 1 call and no query", source.calls, query)
+	}
+}
+
+func TestRelationsErrors(t *testing.T) {
+	_, err := channel.Relations(context.Background(), "0123456789abcdef0123456789abcdef", "_added")
+	if !errors.Is(err, channel.ErrCredentialKindMismatch) || !strings.HasPrefix(err.Error(), channel.OpRelations+": ") {
+		t.Errorf("got %v, want a credential kind mismatch prefixed with %s", err, channel.OpRelations)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := channel.Relations(ctx, testToken); !errors.Is(err, context.Canceled) {
+		t.Errorf("got %v, want %v", err, context.Canceled)
+	}
+}
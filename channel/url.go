@@ -0,0 +1,35 @@
+// Copyright 2022 Sanha Maeng, Soyang Baek, Jinmyeong Kim
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 		http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package channel
+
+import "net/url"
+
+const home = "https://pf.kakao.com/"
+
+// AddURL returns the URL adding the channel of @publicID, such as _ZeUTxl.
+//
+// The user is sent back to @returnURL after adding the channel, unless it is empty.
+func AddURL(publicID, returnURL string) string {
+	addURL := home + url.PathEscape(publicID) + "/friend"
+	if returnURL != "" {
+		addURL += "?" + url.Values{"return_url": {returnURL}}.Encode()
+	}
+	return addURL
+}
+
+// ChatURL returns the URL starting a chat with the channel of @publicID, such as _ZeUTxl.
+func ChatURL(publicID string) string {
+	return home + url.PathEscape(publicID) + "/chat"
+}
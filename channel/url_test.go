@@ -0,0 +1,40 @@
+// Copyright 2022 Sanha Maeng, Soyang Baek, Jinmyeong Kim
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 		http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package channel_test
+
+import (
+	"testing"
+
+	"github.com/maengsanha/kakao-developers-client/channel"
+)
+
+func TestAddURL(t *testing.T) {
+	for _, tc := range []struct{ publicID, returnURL, want string }{
+		{"_ZeUTxl", "", "https://pf.kakao.com/_ZeUTxl/friend"},
+		{"_ZeUTxl", "https://example.com/가입?step=2",
+			"https://pf.kakao.com/_ZeUTxl/friend?return_url=https%3A%2F%2Fexample.com%2F%EA%B0%80%EC%9E%85%3Fstep%3D2"},
+		{"_a/b", "", "https://pf.kakao.com/_a%2Fb/friend"},
+	} {
+		if got := channel.AddURL(tc.publicID, tc.returnURL); got != tc.want {
+			t.Errorf("got %s, want %s", got, tc.want)
+		}
+	}
+}
+
+func TestChatURL(t *testing.T) {
+	if got, want := channel.ChatURL("_ZeUTxl"), "https://pf.kakao.com/_ZeUTxl/chat"; got != want {
+		t.Errorf("got %s, want %s", got, want)
+	}
+}
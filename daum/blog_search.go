@@ -55,6 +55,7 @@ type BlogSearchIterator struct {
 	Size    int
 	AuthKey string
 	end     bool
+	offset  common.PageOffset
 }
 
 // BlogSearch allows to search blog posts by @query in the Daum Blog service.
@@ -97,6 +98,7 @@ func (it *BlogSearchIterator) SortBy(order string) *BlogSearchIterator {
 func (it *BlogSearchIterator) Result(page int) *BlogSearchIterator {
 	if 1 <= page && page <= 50 {
 		it.Page = page
+		it.offset.Reset()
 	} else {
 		panic(common.ErrPageOutOfBound)
 	}
@@ -121,15 +123,21 @@ func (it *BlogSearchIterator) Display(size int) *BlogSearchIterator {
 
 // Next returns the blog search result and proceeds the iterator to the next page.
 func (it *BlogSearchIterator) Next() (res BlogSearchResult, err error) {
+	defer common.WrapError(OpBlogSearch, &err)
+
 	if it.end {
 		return res, Done
 	}
 
-	client := &http.Client{}
+	var skip int
+	if it.Page, skip = it.offset.Realign(it.Page, it.Size); 50 < it.Page {
+		it.end = true
+		return res, Done
+	}
 
 	req, err := http.NewRequest(http.MethodGet,
-		fmt.Sprintf("%sblog?query=%s&sort=%s&page=%d&size=%d",
-			prefix, it.Query, it.Sort, it.Page, it.Size), nil)
+		fmt.Sprintf("%s?query=%s&sort=%s&page=%d&size=%d",
+			endpoint("blog"), it.Query, it.Sort, it.Page, it.Size), nil)
 
 	if err != nil {
 		return
@@ -139,7 +147,7 @@ func (it *BlogSearchIterator) Next() (res BlogSearchResult, err error) {
 
 	req.Header.Set(common.Authorization, it.AuthKey)
 
-	resp, err := client.Do(req)
+	resp, err := common.Do(Service, req)
 	if err != nil {
 		return
 	}
@@ -150,6 +158,9 @@ func (it *BlogSearchIterator) Next() (res BlogSearchResult, err error) {
 		return
 	}
 
+	res.Documents = res.Documents[common.Skip(skip, len(res.Documents)):]
+	it.offset.Advance(it.Page, it.Size)
+
 	it.end = res.Meta.IsEnd || 50 < it.Page
 
 	it.Page++
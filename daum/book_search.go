@@ -15,6 +15,7 @@
 package daum
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"internal/common"
@@ -62,7 +63,14 @@ type BookSearchIterator struct {
 	Page    int
 	Size    int
 	Target  string
+	Targets []string
 	end     bool
+	offset  common.PageOffset
+
+	// states of the search by multiple targets
+	targetEnds  []bool
+	targetMetas []common.PageableMeta
+	seen        map[string]bool
 }
 
 // BookSearch allows to search books by @query in the Daum Book service.
@@ -106,6 +114,8 @@ func (it *BookSearchIterator) SortBy(order string) *BookSearchIterator {
 func (it *BookSearchIterator) Result(page int) *BookSearchIterator {
 	if 1 <= page && page <= 50 {
 		it.Page = page
+		it.offset.Reset()
+		it.resetTargets()
 	} else {
 		panic(common.ErrPageOutOfBound)
 	}
@@ -135,7 +145,8 @@ func (it *BookSearchIterator) Display(size int) *BookSearchIterator {
 func (it *BookSearchIterator) Filter(target string) *BookSearchIterator {
 	switch target {
 	case "title", "isbn", "publisher", "person", "":
-		it.Target = target
+		it.Target, it.Targets = target, nil
+		it.resetTargets()
 	default:
 		panic(errors.New(
 			`target must be one of the following options:
@@ -147,16 +158,84 @@ func (it *BookSearchIterator) Filter(target string) *BookSearchIterator {
 	return it
 }
 
+// FilterMulti limits the search field to any of @targets.
+//
+// @targets can be some of the following options:
+// title, isbn, publisher, person
+//
+// As the API accepts a single target, a request is sent per target for each page.
+// The documents are merged in the order of @targets without duplicated ISBNs,
+// and the metadata sums up the counts of all the targets.
+func (it *BookSearchIterator) FilterMulti(targets ...string) *BookSearchIterator {
+	var filtered []string
+	for _, target := range targets {
+		switch target {
+		case "title", "isbn", "publisher", "person":
+		default:
+			panic(errors.New(
+				`targets must be some of the following options:
+				title, isbn, publisher, person`))
+		}
+		if indexOf(filtered, target) < 0 {
+			filtered = append(filtered, target)
+		}
+	}
+	if r := recover(); r != nil {
+		log.Panicln(r)
+	}
+
+	if len(filtered) == 1 {
+		return it.Filter(filtered[0])
+	}
+	it.Target, it.Targets = "", filtered
+	it.resetTargets()
+	return it
+}
+
+// resetTargets forgets the states of the search by multiple targets,
+// so that the books already returned are returned again from the page the iterator moves to.
+func (it *BookSearchIterator) resetTargets() {
+	it.targetEnds, it.targetMetas, it.seen = nil, nil, nil
+}
+
 // Next returns the book search result and proceeds the iterator to the next page.
-func (it *BookSearchIterator) Next() (res BookSearchResult, err error) {
+func (it *BookSearchIterator) Next() (BookSearchResult, error) { return it.next(context.Background()) }
+
+// next is like Next, but sends the requests with @ctx.
+func (it *BookSearchIterator) next(ctx context.Context) (res BookSearchResult, err error) {
+	defer common.WrapError(OpBookSearch, &err)
+
 	if it.end {
 		return res, Done
 	}
 
-	client := &http.Client{}
-	req, err := http.NewRequest(http.MethodGet,
-		fmt.Sprintf("https://dapi.kakao.com/v3/search/book?query=%s&sort=%s&page=%d&size=%d&target=%s",
-			it.Query, it.Sort, it.Page, it.Size, it.Target), nil)
+	var skip int
+	if it.Page, skip = it.offset.Realign(it.Page, it.Size); 50 < it.Page {
+		it.end = true
+		return res, Done
+	}
+
+	if len(it.Targets) == 0 {
+		res, err = it.search(ctx, it.Target, skip)
+	} else {
+		res, err = it.searchTargets(ctx, skip)
+	}
+	if err != nil {
+		return
+	}
+	it.offset.Advance(it.Page, it.Size)
+
+	it.Page++
+	it.end = res.Meta.IsEnd || 50 < it.Page
+
+	return
+}
+
+// search requests the current page of the documents found by @target, skipping the first @skip documents.
+func (it *BookSearchIterator) search(ctx context.Context, target string, skip int) (res BookSearchResult, err error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet,
+		fmt.Sprintf("%s?query=%s&sort=%s&page=%d&size=%d&target=%s",
+			endpoint("book"), it.Query, it.Sort, it.Page, it.Size, target), nil)
 
 	if err != nil {
 		return
@@ -166,7 +245,7 @@ func (it *BookSearchIterator) Next() (res BookSearchResult, err error) {
 
 	req.Header.Set(common.Authorization, it.AuthKey)
 
-	resp, err := client.Do(req)
+	resp, err := common.Do(Service, req)
 	if err != nil {
 		return
 	}
@@ -176,14 +255,84 @@ func (it *BookSearchIterator) Next() (res BookSearchResult, err error) {
 	if err = json.NewDecoder(resp.Body).Decode(&res); err != nil {
 		return
 	}
-	it.Page++
-	it.end = res.Meta.IsEnd || 50 < it.Page
+	res.Documents = res.Documents[common.Skip(skip, len(res.Documents)):]
+
+	return
+}
+
+// searchTargets requests the current page of each target which has not reached the end,
+// merging the documents in the order of the targets without the books already returned.
+func (it *BookSearchIterator) searchTargets(ctx context.Context, skip int) (res BookSearchResult, err error) {
+	if it.targetEnds == nil {
+		it.targetEnds = make([]bool, len(it.Targets))
+		it.targetMetas = make([]common.PageableMeta, len(it.Targets))
+		it.seen = map[string]bool{}
+	}
+
+	var (
+		items = make(BookSearchResults, len(it.Targets))
+		errs  = make([]error, len(it.Targets))
+		wg    sync.WaitGroup
+	)
+
+	for idx, target := range it.Targets {
+		if it.targetEnds[idx] {
+			continue
+		}
+		wg.Add(1)
+		go func(idx int, target string) {
+			defer wg.Done()
+			items[idx], errs[idx] = it.search(ctx, target, skip)
+		}(idx, target)
+	}
+	wg.Wait()
+
+	for _, err := range errs {
+		if err != nil {
+			return res, err
+		}
+	}
+
+	res.Meta.IsEnd = true
+	for idx, item := range items {
+		if !it.targetEnds[idx] {
+			it.targetMetas[idx], it.targetEnds[idx] = item.Meta, item.Meta.IsEnd
+		}
+
+		res.Meta.TotalCount += it.targetMetas[idx].TotalCount
+		res.Meta.PageableCount += it.targetMetas[idx].PageableCount
+		res.Meta.IsEnd = res.Meta.IsEnd && it.targetEnds[idx]
+
+		for _, doc := range item.Documents {
+			if isbn := isbnOf(doc.ISBN); isbn != "" {
+				if it.seen[isbn] {
+					continue
+				}
+				it.seen[isbn] = true
+			}
+			res.Documents = append(res.Documents, doc)
+		}
+	}
 
 	return
 }
 
 // CollectAll collects all the remaining book search results.
+//
+// The pages of a search by multiple targets are collected one by one, to merge them in order.
 func (it *BookSearchIterator) CollectAll() (results BookSearchResults) {
+	if 0 < len(it.Targets) {
+		for {
+			result, err := it.Next()
+			if err != nil {
+				break
+			}
+			results = append(results, result)
+		}
+		it.end = true
+		return
+	}
+
 	result, err := it.Next()
 	if err == nil {
 		results = append(results, result)
@@ -15,10 +15,19 @@
 package daum_test
 
 import (
+	"errors"
+	"fmt"
 	"internal/common"
+	"net/http"
+	"reflect"
+	"strings"
+	"sync/atomic"
 	"testing"
+	"time"
 
+	"github.com/goccy/go-json"
 	"github.com/maengsanha/kakao-developers-client/daum"
+	"github.com/maengsanha/kakao-developers-client/internal/mock"
 )
 
 func TestBookSearchWithJSON(t *testing.T) {
@@ -89,3 +98,184 @@ func TestBookSearchCollectAll(t *testing.T) {
 		t.Log(item)
 	}
 }
+
+func TestBookSearchErrorWrapping(t *testing.T) {
+	mock.Kakao(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("query") == "end" {
+			fmt.Fprint(w, `{"meta":{"is_end":true}}`)
+		} else {
+			fmt.Fprint(w, "not json")
+		}
+	})
+
+	_, err := daum.BookSearch("히가시노 게이고").Next()
+
+	var syntax *json.SyntaxError
+	if err == nil || !strings.HasPrefix(err.Error(), daum.OpBookSearch+": ") || !errors.As(err, &syntax) {
+		t.Errorf("got %v, want a syntax error prefixed with %s", err, daum.OpBookSearch)
+	}
+
+	// the end of iteration is not wrapped, so that it can be compared with Done
+	it := daum.BookSearch("end")
+	if _, err := it.Next(); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := it.Next(); err != daum.Done {
+		t.Errorf("got %v, want %v", err, daum.Done)
+	}
+}
+
+func TestBookSearchCircuitOpen(t *testing.T) {
+	mock.Kakao(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+		fmt.Fprint(w, `{"meta":{"is_end":false}}`)
+	})
+
+	common.SetCircuitBreaker(daum.Service, common.CircuitBreakerConfig{Failures: 1, CoolDown: time.Hour})
+	t.Cleanup(func() { common.SetCircuitBreaker(daum.Service, common.CircuitBreakerConfig{}) })
+
+	// the unavailable service opens the circuit
+	it := daum.BookSearch("히가시노 게이고")
+	if _, err := it.Next(); err != nil {
+		t.Fatal(err)
+	}
+
+	_, err := it.Next()
+	if !errors.Is(err, daum.ErrCircuitOpen) || !strings.HasPrefix(err.Error(), daum.OpBookSearch+": ") {
+		t.Errorf("got %v, want %v prefixed with %s", err, daum.ErrCircuitOpen, daum.OpBookSearch)
+	}
+}
+
+func TestBookSearchFilterMulti(t *testing.T) {
+	pages := map[string][]string{
+		"title": {
+			`{"meta":{"total_count":3,"pageable_count":3,"is_end":false},"documents":[{"title":"A","isbn":"1 11"},{"title":"B","isbn":"2 22"}]}`,
+			`{"meta":{"total_count":3,"pageable_count":3,"is_end":true},"documents":[{"title":"C","isbn":"3 33"}]}`,
+		},
+		"person": {
+			`{"meta":{"total_count":3,"pageable_count":2,"is_end":true},"documents":[{"title":"B","isbn":"9 22"},{"title":"D","isbn":""},{"title":"E","isbn":"4 44"}]}`,
+		},
+	}
+
+	var requests int32
+	mock.Kakao(t, func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		page, _ := mock.Page(r)
+		fmt.Fprint(w, pages[r.URL.Query().Get("target")][page-1])
+	})
+
+	it := daum.BookSearch("히가시노 게이고").FilterMulti("title", "person", "title").Display(2)
+	if want := []string{"title", "person"}; !reflect.DeepEqual(it.Targets, want) {
+		t.Fatalf("got targets %v, want %v", it.Targets, want)
+	}
+
+	var titles [][]string
+	for _, res := range it.CollectAll() {
+		var page []string
+		for _, doc := range res.Documents {
+			page = append(page, doc.Title)
+		}
+		titles = append(titles, page)
+
+		if res.Meta.TotalCount != 6 || res.Meta.PageableCount != 5 {
+			t.Errorf("got meta %+v, want the sum of both targets", res.Meta)
+		}
+	}
+
+	// the duplicated ISBN of B is dropped, the books without ISBN are kept
+	if want := [][]string{{"A", "B", "D", "E"}, {"C"}}; !reflect.DeepEqual(titles, want) {
+		t.Errorf("got %v, want %v", titles, want)
+	}
+	// the person target ended at the first page, so it is not requested again
+	if requests != 3 {
+		t.Errorf("got %d requests, want 3", requests)
+	}
+}
+
+func TestBookSearchFilterMultiRewind(t *testing.T) {
+	mock.Kakao(t, func(w http.ResponseWriter, r *http.Request) {
+		page, _ := mock.Page(r)
+		fmt.Fprintf(w, `{"meta":{"is_end":false},"documents":[{"title":"%s %d","isbn":"%s%d"}]}`,
+			r.URL.Query().Get("target"), page, r.URL.Query().Get("target"), page)
+	})
+
+	titles := func(res daum.BookSearchResult, err error) (titles []string) {
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, doc := range res.Documents {
+			titles = append(titles, doc.Title)
+		}
+		return
+	}
+
+	it := daum.BookSearch("히가시노 게이고").FilterMulti("title", "person").Display(1)
+	first := titles(it.Next())
+	titles(it.Next())
+
+	// rewinding returns the books of the first page again
+	if got := titles(it.Result(1).Next()); !reflect.DeepEqual(got, first) {
+		t.Errorf("got %v after Result(1), want %v", got, first)
+	}
+
+	// so does changing the targets
+	if got := titles(it.Result(1).FilterMulti("title", "person").Next()); !reflect.DeepEqual(got, first) {
+		t.Errorf("got %v after FilterMulti, want %v", got, first)
+	}
+}
+
+func TestBookSearchFilterMultiSingleTarget(t *testing.T) {
+	it := daum.BookSearch("히가시노 게이고").FilterMulti("isbn")
+	if it.Target != "isbn" || it.Targets != nil {
+		t.Errorf("got target %q and targets %v, want a single target search", it.Target, it.Targets)
+	}
+
+	if _, err := daum.EncodeCursor(daum.BookSearch("x").FilterMulti("title", "person"), []byte("key")); err == nil {
+		t.Error("encoded a cursor of a search by multiple targets")
+	}
+}
+
+func TestBookSearchAPIVersions(t *testing.T) {
+	fixtures := map[string]string{
+		"v3": `{"meta":{"total_count":1,"pageable_count":1,"is_end":true},"documents":[
+			{"title":"용의자 X의 헌신","contents":"...","url":"https://search.daum.net/book","isbn":"8990982618 9788990982612",
+			"datetime":"2006-08-01T00:00:00.000+09:00","authors":["히가시노 게이고"],"publisher":"현대문학","translators":["양억관"],
+			"price":9800,"sale_price":8820,"thumbnail":"https://search1.kakaocdn.net/thumb","status":"정상판매"}]}`,
+		// v2 also returns sale_yn, category and the barcodes, which BookResult leaves out
+		"v2": `{"meta":{"total_count":1,"pageable_count":1,"is_end":true},"documents":[
+			{"title":"용의자 X의 헌신","contents":"...","url":"https://search.daum.net/book","isbn":"8990982618 9788990982612",
+			"datetime":"2006-08-01T00:00:00.000+09:00","authors":["히가시노 게이고"],"publisher":"현대문학","translators":["양억관"],
+			"price":9800,"sale_price":8820,"sale_yn":"Y","category":"소설","barcode":"BOK00000001","ebook_barcode":"",
+			"thumbnail":"https://search1.kakaocdn.net/thumb","status":"정상판매"}]}`,
+	}
+
+	var path string
+	mock.Kakao(t, func(w http.ResponseWriter, r *http.Request) {
+		path = r.URL.Path
+		fmt.Fprint(w, fixtures[strings.Split(path, "/")[1]])
+	})
+	t.Cleanup(func() { common.SetAPIVersion("daum.book", "v3") })
+
+	var docs []daum.BookResult
+	for _, version := range []string{"v3", "v2"} {
+		if err := common.SetAPIVersion("daum.book", version); err != nil {
+			t.Fatal(err)
+		}
+
+		res, err := daum.BookSearch("용의자 X의 헌신").Next()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := "/" + version + "/search/book"; path != want {
+			t.Errorf("got path %s, want %s", path, want)
+		}
+		if len(res.Documents) != 1 || res.Documents[0].SalePrice != 8820 {
+			t.Fatalf("got %v from %s", res, version)
+		}
+		docs = append(docs, res.Documents[0])
+	}
+
+	if !reflect.DeepEqual(docs[0], docs[1]) {
+		t.Errorf("got %v from v3 and %v from v2, want the same", docs[0], docs[1])
+	}
+}
@@ -55,6 +55,7 @@ type CafeSearchIterator struct {
 	Page    int
 	Size    int
 	end     bool
+	offset  common.PageOffset
 }
 
 // CafeSearch allows users to search posts by @query in the Daum Cafe service.
@@ -97,6 +98,7 @@ func (it *CafeSearchIterator) SortBy(order string) *CafeSearchIterator {
 func (it *CafeSearchIterator) Result(page int) *CafeSearchIterator {
 	if 1 <= page && page <= 50 {
 		it.Page = page
+		it.offset.Reset()
 	} else {
 		panic(common.ErrPageOutOfBound)
 	}
@@ -121,14 +123,21 @@ func (it *CafeSearchIterator) Display(size int) *CafeSearchIterator {
 
 // Next returns the cafe search result and proceeds the iterator to the next page.
 func (it *CafeSearchIterator) Next() (res CafeSearchResult, err error) {
+	defer common.WrapError(OpCafeSearch, &err)
+
 	if it.end {
 		return res, Done
 	}
 
-	client := &http.Client{}
+	var skip int
+	if it.Page, skip = it.offset.Realign(it.Page, it.Size); 50 < it.Page {
+		it.end = true
+		return res, Done
+	}
+
 	req, err := http.NewRequest(http.MethodGet,
-		fmt.Sprintf("%scafe?query=%s&sort=%s&page=%d&size=%d",
-			prefix, it.Query, it.Sort, it.Page, it.Size), nil)
+		fmt.Sprintf("%s?query=%s&sort=%s&page=%d&size=%d",
+			endpoint("cafe"), it.Query, it.Sort, it.Page, it.Size), nil)
 
 	if err != nil {
 		return
@@ -138,7 +147,7 @@ func (it *CafeSearchIterator) Next() (res CafeSearchResult, err error) {
 
 	req.Header.Set(common.Authorization, it.AuthKey)
 
-	resp, err := client.Do(req)
+	resp, err := common.Do(Service, req)
 	if err != nil {
 		return
 	}
@@ -149,6 +158,9 @@ func (it *CafeSearchIterator) Next() (res CafeSearchResult, err error) {
 		return
 	}
 
+	res.Documents = res.Documents[common.Skip(skip, len(res.Documents)):]
+	it.offset.Advance(it.Page, it.Size)
+
 	it.Page++
 
 	it.end = res.Meta.IsEnd || 50 < it.Page
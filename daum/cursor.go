@@ -0,0 +1,125 @@
+// Copyright 2022 Sanha Maeng, Soyang Baek, Jinmyeong Kim
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 		http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package daum
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"internal/common"
+)
+
+// cursorVersion is the layout version of the cursor payload.
+//
+// The payload is laid out as follows, followed by the HMAC-SHA256 of it:
+// version, page, size, last size, sort, target, end (a byte each),
+// offset (2 bytes, big endian) and the query.
+const cursorVersion byte = 1
+
+const cursorHeaderLen = 9
+
+var (
+	cursorSorts   = []string{"accuracy", "latest"}
+	cursorTargets = []string{"", "title", "isbn", "publisher", "person"}
+)
+
+func indexOf(options []string, val string) int {
+	for idx, option := range options {
+		if option == val {
+			return idx
+		}
+	}
+	return -1
+}
+
+func sign(payload, key []byte) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write(payload)
+	return mac.Sum(nil)
+}
+
+// EncodeCursor encodes the state of @it into an opaque, URL-safe token signed with @key,
+// so that the token can be handed out to clients which should not alter the page or size.
+//
+// The authorization key of @it is not a part of the token,
+// and iterators searching by multiple targets cannot be encoded.
+func EncodeCursor(it *BookSearchIterator, key []byte) (string, error) {
+	if len(key) == 0 {
+		return "", ErrEmptyCursorKey
+	}
+
+	sort, target := indexOf(cursorSorts, it.Sort), indexOf(cursorTargets, it.Target)
+	if it.Page < 1 || 255 < it.Page || it.Size < 1 || 255 < it.Size ||
+		sort < 0 || target < 0 || 0xffff < it.offset.Yielded || 0 < len(it.Targets) {
+		return "", ErrInvalidCursor
+	}
+
+	payload := make([]byte, cursorHeaderLen, cursorHeaderLen+len(it.Query)+sha256.Size)
+	payload[0] = cursorVersion
+	payload[1] = byte(it.Page)
+	payload[2] = byte(it.Size)
+	payload[3] = byte(it.offset.Size)
+	payload[4] = byte(sort)
+	payload[5] = byte(target)
+	if it.end {
+		payload[6] = 1
+	}
+	binary.BigEndian.PutUint16(payload[7:], uint16(it.offset.Yielded))
+	payload = append(payload, it.Query...)
+
+	return base64.RawURLEncoding.EncodeToString(append(payload, sign(payload, key)...)), nil
+}
+
+// DecodeCursor restores a book search iterator from @s, a token made by EncodeCursor with @key.
+//
+// The restored iterator has to be authorized again with AuthorizeWith.
+func DecodeCursor(s string, key []byte) (*BookSearchIterator, error) {
+	if len(key) == 0 {
+		return nil, ErrEmptyCursorKey
+	}
+
+	token, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil || len(token) < cursorHeaderLen+sha256.Size {
+		return nil, ErrInvalidCursor
+	}
+
+	payload, mac := token[:len(token)-sha256.Size], token[len(token)-sha256.Size:]
+	if !hmac.Equal(mac, sign(payload, key)) {
+		return nil, ErrInvalidCursor
+	}
+	if payload[0] != cursorVersion {
+		return nil, ErrUnsupportedCursorVersion
+	}
+
+	it := &BookSearchIterator{
+		Query:   string(payload[cursorHeaderLen:]),
+		AuthKey: common.KeyPrefix,
+		Page:    int(payload[1]),
+		Size:    int(payload[2]),
+		end:     payload[6] == 1,
+		offset: common.PageOffset{
+			Yielded: int(binary.BigEndian.Uint16(payload[7:])),
+			Size:    int(payload[3]),
+		},
+	}
+	if int(payload[4]) < len(cursorSorts) && int(payload[5]) < len(cursorTargets) {
+		it.Sort, it.Target = cursorSorts[payload[4]], cursorTargets[payload[5]]
+	} else {
+		return nil, ErrInvalidCursor
+	}
+
+	return it, nil
+}
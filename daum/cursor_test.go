@@ -0,0 +1,123 @@
+// Copyright 2022 Sanha Maeng, Soyang Baek, Jinmyeong Kim
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 		http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package daum_test
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"internal/common"
+	"net/http"
+	"testing"
+
+	"github.com/maengsanha/kakao-developers-client/daum"
+	"github.com/maengsanha/kakao-developers-client/internal/mock"
+)
+
+const testRESTKey = "0123456789abcdef0123456789abcdef"
+
+var cursorKey = []byte("cursor secret")
+
+func TestCursorRoundTrip(t *testing.T) {
+	it := daum.BookSearch("히가시노 게이고").
+		AuthorizeWith(testRESTKey).
+		SortBy("latest").
+		Result(3).
+		Display(20).
+		Filter("person")
+
+	token, err := daum.EncodeCursor(it, cursorKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if bytes.Contains(raw, []byte(testRESTKey)) {
+		t.Error("cursor must not contain the authorization key")
+	}
+
+	restored, err := daum.DecodeCursor(token, cursorKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if restored.Query != it.Query || restored.Sort != it.Sort || restored.Page != it.Page ||
+		restored.Size != it.Size || restored.Target != it.Target {
+		t.Errorf("restored %+v, want %+v", restored, it)
+	}
+	if restored.AuthKey != common.KeyPrefix {
+		t.Errorf("restored authorization key %q, want %q", restored.AuthKey, common.KeyPrefix)
+	}
+
+	mock.Kakao(t, func(w http.ResponseWriter, r *http.Request) {
+		if page, size := mock.Page(r); page != 3 || size != 20 {
+			t.Errorf("requested page %d of size %d, want page 3 of size 20", page, size)
+		}
+		fmt.Fprint(w, daum.BookSearchResult{Meta: common.PageableMeta{IsEnd: true}})
+	})
+
+	if _, err := restored.Next(); err != nil {
+		t.Fatal(err)
+	}
+
+	// an exhausted iterator stays exhausted
+	if token, err = daum.EncodeCursor(restored, cursorKey); err != nil {
+		t.Fatal(err)
+	}
+	if restored, err = daum.DecodeCursor(token, cursorKey); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := restored.Next(); err != daum.Done {
+		t.Errorf("got %v, want %v", err, daum.Done)
+	}
+}
+
+func TestCursorTampered(t *testing.T) {
+	token, err := daum.EncodeCursor(daum.BookSearch("히가시노 게이고"), cursorKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	raw, _ := base64.RawURLEncoding.DecodeString(token)
+	for idx := range raw {
+		tampered := append([]byte{}, raw...)
+		tampered[idx] ^= 1
+		if _, err := daum.DecodeCursor(base64.RawURLEncoding.EncodeToString(tampered), cursorKey); err != daum.ErrInvalidCursor {
+			t.Errorf("tampering byte %d: got %v, want %v", idx, err, daum.ErrInvalidCursor)
+		}
+	}
+
+	for _, token := range []string{"", "not a cursor", token[:len(token)-4]} {
+		if _, err := daum.DecodeCursor(token, cursorKey); err != daum.ErrInvalidCursor {
+			t.Errorf("decoding %q: got %v, want %v", token, err, daum.ErrInvalidCursor)
+		}
+	}
+}
+
+func TestCursorWrongKey(t *testing.T) {
+	token, err := daum.EncodeCursor(daum.BookSearch("히가시노 게이고"), cursorKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := daum.DecodeCursor(token, []byte("another secret")); err != daum.ErrInvalidCursor {
+		t.Errorf("got %v, want %v", err, daum.ErrInvalidCursor)
+	}
+	if _, err := daum.DecodeCursor(token, nil); err != daum.ErrEmptyCursorKey {
+		t.Errorf("got %v, want %v", err, daum.ErrEmptyCursorKey)
+	}
+}
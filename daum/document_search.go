@@ -58,6 +58,7 @@ type DocumentSearchIterator struct {
 	Size    int
 	AuthKey string
 	end     bool
+	offset  common.PageOffset
 }
 
 // DocumentSearch allows to search web documents by @query in the Daum Search service.
@@ -100,6 +101,7 @@ func (it *DocumentSearchIterator) SortBy(order string) *DocumentSearchIterator {
 func (it *DocumentSearchIterator) Result(page int) *DocumentSearchIterator {
 	if 1 <= page && page <= 50 {
 		it.Page = page
+		it.offset.Reset()
 	} else {
 		panic(common.ErrPageOutOfBound)
 	}
@@ -124,15 +126,21 @@ func (it *DocumentSearchIterator) Display(size int) *DocumentSearchIterator {
 
 // Next returns the document search result and proceeds the iterator to the next page.
 func (it *DocumentSearchIterator) Next() (res DocumentSearchResult, err error) {
+	defer common.WrapError(OpDocumentSearch, &err)
+
 	if it.end {
 		return res, Done
 	}
 
-	client := &http.Client{}
+	var skip int
+	if it.Page, skip = it.offset.Realign(it.Page, it.Size); 50 < it.Page {
+		it.end = true
+		return res, Done
+	}
 
 	req, err := http.NewRequest(http.MethodGet,
-		fmt.Sprintf("%sweb?query=%s&sort=%s&page=%d&size=%d",
-			prefix, it.Query, it.Sort, it.Page, it.Size), nil)
+		fmt.Sprintf("%s?query=%s&sort=%s&page=%d&size=%d",
+			endpoint("web"), it.Query, it.Sort, it.Page, it.Size), nil)
 
 	if err != nil {
 		return
@@ -142,7 +150,7 @@ func (it *DocumentSearchIterator) Next() (res DocumentSearchResult, err error) {
 
 	req.Header.Set(common.Authorization, it.AuthKey)
 
-	resp, err := client.Do(req)
+	resp, err := common.Do(Service, req)
 	if err != nil {
 		return
 	}
@@ -153,6 +161,9 @@ func (it *DocumentSearchIterator) Next() (res DocumentSearchResult, err error) {
 		return
 	}
 
+	res.Documents = res.Documents[common.Skip(skip, len(res.Documents)):]
+	it.offset.Advance(it.Page, it.Size)
+
 	it.end = res.Meta.IsEnd || 50 < it.Page
 
 	it.Page++
@@ -15,10 +15,13 @@
 package daum_test
 
 import (
+	"fmt"
 	"internal/common"
+	"net/http"
 	"testing"
 
 	"github.com/maengsanha/kakao-developers-client/daum"
+	"github.com/maengsanha/kakao-developers-client/internal/mock"
 )
 
 func TestDocumentSearchWithJSON(t *testing.T) {
@@ -83,3 +86,45 @@ func TestDocumentSearchCollectAll(t *testing.T) {
 		t.Log(item)
 	}
 }
+
+func TestDocumentSearchDisplayChangedMidIteration(t *testing.T) {
+	const total = 120
+
+	mock.Kakao(t, func(w http.ResponseWriter, r *http.Request) {
+		page, size := mock.Page(r)
+		res := daum.DocumentSearchResult{}
+		for idx := (page - 1) * size; idx < page*size && idx < total; idx++ {
+			res.Documents = append(res.Documents, daum.WebResult{Title: fmt.Sprint(idx)})
+		}
+		res.Meta.IsEnd = total <= page*size
+		fmt.Fprint(w, res)
+	})
+
+	it := daum.DocumentSearch("Alan Turing").Display(10)
+
+	var titles []string
+	for {
+		if len(titles) == 30 {
+			it.Display(50)
+		}
+		item, err := it.Next()
+		if err == daum.Done {
+			break
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, doc := range item.Documents {
+			titles = append(titles, doc.Title)
+		}
+	}
+
+	if len(titles) != total {
+		t.Fatalf("got %d documents, want %d", len(titles), total)
+	}
+	for idx, title := range titles {
+		if title != fmt.Sprint(idx) {
+			t.Fatalf("document %d is %s, want %d", idx, title, idx)
+		}
+	}
+}
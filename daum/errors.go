@@ -14,6 +14,16 @@
 
 package daum
 
-import "internal/common"
+import (
+	"errors"
+	"internal/common"
+)
 
-var Done = common.ErrEndPage
+var (
+	Done                        = common.ErrEndPage
+	ErrCredentialKindMismatch   = common.ErrCredentialKindMismatch
+	ErrCircuitOpen              = common.ErrCircuitOpen
+	ErrEmptyCursorKey           = errors.New("cursor key must not be empty")
+	ErrInvalidCursor            = errors.New("invalid cursor")
+	ErrUnsupportedCursorVersion = errors.New("unsupported cursor version")
+)
@@ -61,6 +61,7 @@ type ImageSearchIterator struct {
 	Size    int
 	AuthKey string
 	end     bool
+	offset  common.PageOffset
 }
 
 // ImageSearch allows users to search images by @query in the Daum Search service.
@@ -103,6 +104,7 @@ func (it *ImageSearchIterator) SortBy(order string) *ImageSearchIterator {
 func (it *ImageSearchIterator) Result(page int) *ImageSearchIterator {
 	if 1 <= page && page <= 50 {
 		it.Page = page
+		it.offset.Reset()
 	} else {
 		panic(common.ErrPageOutOfBound)
 	}
@@ -127,15 +129,21 @@ func (it *ImageSearchIterator) Display(size int) *ImageSearchIterator {
 
 // Next returns the image search result and proceeds the iterator to the next page.
 func (it *ImageSearchIterator) Next() (res ImageSearchResult, err error) {
+	defer common.WrapError(OpImageSearch, &err)
+
 	if it.end {
 		return res, Done
 	}
 
-	client := &http.Client{}
+	var skip int
+	if it.Page, skip = it.offset.Realign(it.Page, it.Size); 50 < it.Page {
+		it.end = true
+		return res, Done
+	}
 
 	req, err := http.NewRequest(http.MethodGet,
-		fmt.Sprintf("%simage?query=%s&sort=%s&page=%d&size=%d",
-			prefix, it.Query, it.Sort, it.Page, it.Size), nil)
+		fmt.Sprintf("%s?query=%s&sort=%s&page=%d&size=%d",
+			endpoint("image"), it.Query, it.Sort, it.Page, it.Size), nil)
 
 	if err != nil {
 		return
@@ -145,7 +153,7 @@ func (it *ImageSearchIterator) Next() (res ImageSearchResult, err error) {
 
 	req.Header.Set(common.Authorization, it.AuthKey)
 
-	resp, err := client.Do(req)
+	resp, err := common.Do(Service, req)
 	if err != nil {
 		return
 	}
@@ -156,6 +164,9 @@ func (it *ImageSearchIterator) Next() (res ImageSearchResult, err error) {
 		return
 	}
 
+	res.Documents = res.Documents[common.Skip(skip, len(res.Documents)):]
+	it.offset.Advance(it.Page, it.Size)
+
 	it.end = res.Meta.IsEnd || 50 < it.Page
 
 	it.Page++
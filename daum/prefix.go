@@ -15,4 +15,9 @@
 // Package daum provides the features of the Daum Search API.
 package daum
 
-const prefix = "https://dapi.kakao.com/v2/search/"
+import "internal/common"
+
+// endpoint returns the URL of the search of @target, in the version set by common.SetAPIVersion.
+func endpoint(target string) string {
+	return "https://dapi.kakao.com/" + common.APIVersion("daum."+target) + "/search/" + target
+}
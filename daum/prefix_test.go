@@ -0,0 +1,140 @@
+// Copyright 2022 Sanha Maeng, Soyang Baek, Jinmyeong Kim
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 		http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package daum_test
+
+import (
+	"fmt"
+	"internal/common"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/maengsanha/kakao-developers-client/daum"
+	"github.com/maengsanha/kakao-developers-client/internal/mock"
+)
+
+// versionFixtures are the documents of each search endpoint by version, which all link to https://example.com/<endpoint>.
+var versionFixtures = map[string]map[string]string{
+	"web": {
+		"v2": `{"title":"Alan Turing","contents":"...","url":"https://example.com/web","datetime":"2022-01-01T00:00:00.000+09:00"}`,
+	},
+	"vclip": {
+		"v2": `{"title":"Alan Turing","url":"https://example.com/vclip","datetime":"2022-01-01T00:00:00.000+09:00",
+			"play_time":120,"thumbnail":"https://example.com/thumb","author":"BBC"}`,
+	},
+	"image": {
+		"v2": `{"collection":"news","thumbnail_url":"https://example.com/thumb","image_url":"https://example.com/image",
+			"width":640,"height":480,"display_sitename":"BBC","doc_url":"https://example.com/doc","datetime":"2022-01-01T00:00:00.000+09:00"}`,
+	},
+	"blog": {
+		"v2": `{"title":"Alan Turing","contents":"...","url":"https://example.com/blog","datetime":"2022-01-01T00:00:00.000+09:00",
+			"blogname":"computing","thumbnail":"https://example.com/thumb"}`,
+	},
+	"book": {
+		"v3": `{"title":"Alan Turing: The Enigma","contents":"...","url":"https://example.com/book","isbn":"0691164720 9780691164724",
+			"datetime":"2014-11-10T00:00:00.000+09:00","authors":["Andrew Hodges"],"publisher":"Princeton","translators":[],
+			"price":20000,"sale_price":18000,"thumbnail":"https://example.com/thumb","status":"정상판매"}`,
+		"v2": `{"title":"Alan Turing: The Enigma","contents":"...","url":"https://example.com/book","isbn":"0691164720 9780691164724",
+			"datetime":"2014-11-10T00:00:00.000+09:00","authors":["Andrew Hodges"],"publisher":"Princeton","translators":[],
+			"price":20000,"sale_price":18000,"sale_yn":"Y","category":"과학","barcode":"BOK00000001","ebook_barcode":"",
+			"thumbnail":"https://example.com/thumb","status":"정상판매"}`,
+	},
+	"cafe": {
+		"v2": `{"title":"Alan Turing","contents":"...","url":"https://example.com/cafe","datetime":"2022-01-01T00:00:00.000+09:00",
+			"cafename":"computing","thumbnail":"https://example.com/thumb"}`,
+	},
+}
+
+func TestEndpointVersions(t *testing.T) {
+	var path string
+	mock.Kakao(t, func(w http.ResponseWriter, r *http.Request) {
+		path = r.URL.Path
+		// /<version>/search/<endpoint>
+		tokens := strings.Split(path, "/")
+		fmt.Fprintf(w, `{"meta":{"total_count":1,"pageable_count":1,"is_end":true},"documents":[%s]}`,
+			versionFixtures[tokens[3]][tokens[1]])
+	})
+
+	// search returns the link of the only document found by the search of each endpoint
+	search := map[string]func() (string, error){
+		"web": func() (string, error) {
+			res, err := daum.DocumentSearch("Alan Turing").Next()
+			if err != nil || len(res.Documents) != 1 {
+				return "", err
+			}
+			return res.Documents[0].URL, nil
+		},
+		"vclip": func() (string, error) {
+			res, err := daum.VideoSearch("Alan Turing").Next()
+			if err != nil || len(res.Documents) != 1 {
+				return "", err
+			}
+			return res.Documents[0].URL, nil
+		},
+		"image": func() (string, error) {
+			res, err := daum.ImageSearch("Alan Turing").Next()
+			if err != nil || len(res.Documents) != 1 {
+				return "", err
+			}
+			return res.Documents[0].ImageURL, nil
+		},
+		"blog": func() (string, error) {
+			res, err := daum.BlogSearch("Alan Turing").Next()
+			if err != nil || len(res.Documents) != 1 {
+				return "", err
+			}
+			return res.Documents[0].URL, nil
+		},
+		"book": func() (string, error) {
+			res, err := daum.BookSearch("Alan Turing").Next()
+			if err != nil || len(res.Documents) != 1 {
+				return "", err
+			}
+			return res.Documents[0].URL, nil
+		},
+		"cafe": func() (string, error) {
+			res, err := daum.CafeSearch("Alan Turing").Next()
+			if err != nil || len(res.Documents) != 1 {
+				return "", err
+			}
+			return res.Documents[0].URL, nil
+		},
+	}
+
+	for endpoint, fixtures := range versionFixtures {
+		versions := common.APIVersions("daum." + endpoint)
+		if len(versions) != len(fixtures) {
+			t.Errorf("%s supports %v, but has fixtures of %d versions", endpoint, versions, len(fixtures))
+		}
+
+		for _, version := range versions {
+			if err := common.SetAPIVersion("daum."+endpoint, version); err != nil {
+				t.Fatal(err)
+			}
+
+			link, err := search[endpoint]()
+			if err != nil {
+				t.Errorf("%s %s: %v", endpoint, version, err)
+			} else if want := "https://example.com/" + endpoint; link != want {
+				t.Errorf("%s %s: got %q, want %q", endpoint, version, link, want)
+			}
+			if want := "/" + version + "/search/" + endpoint; path != want {
+				t.Errorf("%s %s: got path %s, want %s", endpoint, version, path, want)
+			}
+		}
+
+		common.SetAPIVersion("daum."+endpoint, versions[0])
+	}
+}
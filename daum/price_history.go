@@ -0,0 +1,178 @@
+// Copyright 2022 Sanha Maeng, Soyang Baek, Jinmyeong Kim
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 		http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package daum
+
+import (
+	"bufio"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/goccy/go-json"
+)
+
+// PricePoint represents the prices of a book at a moment.
+type PricePoint struct {
+	ISBN      string    `json:"isbn"`
+	Title     string    `json:"title"`
+	Price     int       `json:"price"`
+	SalePrice int       `json:"sale_price"`
+	At        time.Time `json:"at"`
+}
+
+// PriceChange represents a change of the prices of a book between two consecutive records.
+type PriceChange struct {
+	Before PricePoint `json:"before"`
+	After  PricePoint `json:"after"`
+}
+
+// PriceHistory is an append-only store of book prices, kept as JSON lines in a file.
+//
+// A PriceHistory is safe for concurrent use, and is the single writer of its file:
+// open a file with at most one PriceHistory at a time.
+type PriceHistory struct {
+	filename string
+	mu       sync.RWMutex
+	points   map[string][]PricePoint
+}
+
+// isbnOf returns the key of @isbn, which is the ISBN13 if Kakao provides both ISBN10 and ISBN13.
+func isbnOf(isbn string) string {
+	fields := strings.Fields(isbn)
+	if len(fields) == 0 {
+		return ""
+	}
+	return fields[len(fields)-1]
+}
+
+// OpenPriceHistory opens the price history stored in @filename, creating it if it does not exist.
+func OpenPriceHistory(filename string) (*PriceHistory, error) {
+	ph := &PriceHistory{filename: filename, points: map[string][]PricePoint{}}
+
+	file, err := os.OpenFile(filename, os.O_RDONLY|os.O_CREATE, 0o644)
+	if err != nil {
+		return nil, err
+	}
+
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(nil, 1024*1024)
+	for scanner.Scan() {
+		if len(scanner.Bytes()) == 0 {
+			continue
+		}
+		var point PricePoint
+		if err := json.Unmarshal(scanner.Bytes(), &point); err != nil {
+			return nil, err
+		}
+		ph.add(point)
+	}
+
+	return ph, scanner.Err()
+}
+
+func (ph *PriceHistory) add(point PricePoint) {
+	series := append(ph.points[point.ISBN], point)
+	sort.SliceStable(series, func(i, j int) bool { return series[i].At.Before(series[j].At) })
+	ph.points[point.ISBN] = series
+}
+
+// Record appends the prices of the books in @results at @at.
+//
+// Books without an ISBN are skipped, and a book found more than once is recorded once.
+// The lines are appended in the order of @results, so recording the same results at the same @at
+// always appends the same bytes.
+func (ph *PriceHistory) Record(results BookSearchResults, at time.Time) error {
+	var (
+		points []PricePoint
+		lines  []byte
+		seen   = map[string]bool{}
+	)
+
+	for _, result := range results {
+		for _, doc := range result.Documents {
+			isbn := isbnOf(doc.ISBN)
+			if isbn == "" || seen[isbn] {
+				continue
+			}
+			seen[isbn] = true
+
+			point := PricePoint{ISBN: isbn, Title: doc.Title, Price: doc.Price, SalePrice: doc.SalePrice, At: at}
+			line, err := json.Marshal(point)
+			if err != nil {
+				return err
+			}
+			points, lines = append(points, point), append(append(lines, line...), '\n')
+		}
+	}
+
+	ph.mu.Lock()
+	defer ph.mu.Unlock()
+
+	file, err := os.OpenFile(ph.filename, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0o644)
+	if err != nil {
+		return err
+	}
+
+	if _, err = file.Write(lines); err != nil {
+		file.Close()
+		return err
+	}
+	if err = file.Close(); err != nil {
+		return err
+	}
+
+	for _, point := range points {
+		ph.add(point)
+	}
+
+	return nil
+}
+
+// Series returns the prices of the book of @isbn in chronological order.
+func (ph *PriceHistory) Series(isbn string) []PricePoint {
+	ph.mu.RLock()
+	defer ph.mu.RUnlock()
+
+	return append([]PricePoint(nil), ph.points[isbnOf(isbn)]...)
+}
+
+// Changed returns the price changes recorded after @since,
+// in chronological order and by ISBN for changes recorded at the same moment.
+func (ph *PriceHistory) Changed(since time.Time) (changes []PriceChange) {
+	ph.mu.RLock()
+	defer ph.mu.RUnlock()
+
+	for _, series := range ph.points {
+		for idx := 1; idx < len(series); idx++ {
+			before, after := series[idx-1], series[idx]
+			if after.At.After(since) && (before.Price != after.Price || before.SalePrice != after.SalePrice) {
+				changes = append(changes, PriceChange{Before: before, After: after})
+			}
+		}
+	}
+
+	sort.Slice(changes, func(i, j int) bool {
+		if !changes[i].After.At.Equal(changes[j].After.At) {
+			return changes[i].After.At.Before(changes[j].After.At)
+		}
+		return changes[i].After.ISBN < changes[j].After.ISBN
+	})
+
+	return
+}
@@ -0,0 +1,129 @@
+// Copyright 2022 Sanha Maeng, Soyang Baek, Jinmyeong Kim
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 		http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package daum_test
+
+import (
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/maengsanha/kakao-developers-client/daum"
+)
+
+func bookPrices(prices map[string]int) daum.BookSearchResults {
+	result := daum.BookSearchResult{}
+	for isbn, price := range prices {
+		result.Documents = append(result.Documents, daum.BookResult{ISBN: isbn, Price: 20000, SalePrice: price})
+	}
+	return daum.BookSearchResults{result}
+}
+
+func TestPriceHistory(t *testing.T) {
+	filename := filepath.Join(t.TempDir(), "prices.jsonl")
+
+	ph, err := daum.OpenPriceHistory(filename)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	day1 := time.Date(2022, 3, 1, 9, 0, 0, 0, time.UTC)
+	day2, day3 := day1.AddDate(0, 0, 1), day1.AddDate(0, 0, 2)
+
+	// recorded out of order on purpose
+	for _, record := range []struct {
+		at     time.Time
+		prices map[string]int
+	}{
+		{day3, map[string]int{"8972756199 9788972756194": 16200, "9788901219943": 17000}},
+		{day1, map[string]int{"8972756199 9788972756194": 18000, "9788901219943": 18000}},
+		{day2, map[string]int{"8972756199 9788972756194": 16200, "9788901219943": 18000}},
+	} {
+		if err := ph.Record(bookPrices(record.prices), record.at); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// the history is read back from the file
+	if ph, err = daum.OpenPriceHistory(filename); err != nil {
+		t.Fatal(err)
+	}
+
+	series := ph.Series("8972756199 9788972756194")
+	if len(series) != 3 {
+		t.Fatalf("got %d points, want 3", len(series))
+	}
+	for idx, want := range []struct {
+		at    time.Time
+		price int
+	}{{day1, 18000}, {day2, 16200}, {day3, 16200}} {
+		if !series[idx].At.Equal(want.at) || series[idx].SalePrice != want.price {
+			t.Errorf("point %d is %+v, want %d at %v", idx, series[idx], want.price, want.at)
+		}
+	}
+	if len(ph.Series("9788972756194")) != 3 {
+		t.Error("series should be found by ISBN13 alone")
+	}
+
+	changes := ph.Changed(day1)
+	if len(changes) != 2 {
+		t.Fatalf("got %d changes, want 2", len(changes))
+	}
+	if c := changes[0]; c.After.ISBN != "9788972756194" || !c.After.At.Equal(day2) ||
+		c.Before.SalePrice != 18000 || c.After.SalePrice != 16200 {
+		t.Errorf("unexpected first change %+v", c)
+	}
+	if c := changes[1]; c.After.ISBN != "9788901219943" || !c.After.At.Equal(day3) ||
+		c.Before.SalePrice != 18000 || c.After.SalePrice != 17000 {
+		t.Errorf("unexpected second change %+v", c)
+	}
+
+	if changes := ph.Changed(day2); len(changes) != 1 {
+		t.Errorf("got %d changes since day 2, want 1", len(changes))
+	}
+}
+
+func TestPriceHistoryConcurrentRecord(t *testing.T) {
+	filename := filepath.Join(t.TempDir(), "prices.jsonl")
+
+	ph, err := daum.OpenPriceHistory(filename)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	start := time.Date(2022, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	var wg sync.WaitGroup
+	for hour := 0; hour < 24; hour++ {
+		wg.Add(1)
+		go func(hour int) {
+			defer wg.Done()
+			if err := ph.Record(bookPrices(map[string]int{"9788901219943": 18000 - hour}), start.Add(time.Duration(hour)*time.Hour)); err != nil {
+				t.Error(err)
+			}
+		}(hour)
+	}
+	wg.Wait()
+
+	if ph, err = daum.OpenPriceHistory(filename); err != nil {
+		t.Fatal(err)
+	}
+	if series := ph.Series("9788901219943"); len(series) != 24 {
+		t.Errorf("got %d points, want 24", len(series))
+	}
+	if changes := ph.Changed(start); len(changes) != 23 {
+		t.Errorf("got %d changes, want 23", len(changes))
+	}
+}
@@ -0,0 +1,140 @@
+// Copyright 2022 Sanha Maeng, Soyang Baek, Jinmyeong Kim
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 		http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package daum
+
+import (
+	"context"
+	"internal/common"
+	"strings"
+
+	"github.com/maengsanha/kakao-developers-client/translation"
+)
+
+// smartConfidence is the confidence of the language detection above which a query is translated.
+const smartConfidence = 0.8
+
+// SmartBookResult represents a document of SmartBookSearch, along with the query which found it.
+type SmartBookResult struct {
+	BookResult
+	Query      string `json:"query"`
+	Translated bool   `json:"translated"`
+}
+
+type bookSearchOutcome struct {
+	query string
+	res   BookSearchResult
+	err   error
+}
+
+// searchBooks runs the first page of a book search of @query in the background.
+func searchBooks(ctx context.Context, query, authKey string) <-chan bookSearchOutcome {
+	outcome := make(chan bookSearchOutcome, 1)
+	go func() {
+		res, err := BookSearch(query).AuthorizeWith(authKey).next(ctx)
+		outcome <- bookSearchOutcome{query, res, err}
+	}()
+	return outcome
+}
+
+// searchTranslatedBooks runs the book search of the Korean translation of @query in the background.
+// The outcome has no query if @query should not or could not be translated.
+func searchTranslatedBooks(ctx context.Context, query, authKey string) <-chan bookSearchOutcome {
+	outcome := make(chan bookSearchOutcome, 1)
+	go func() {
+		if translated := translateToKorean(ctx, query, authKey); translated != "" && translated != query {
+			outcome <- <-searchBooks(ctx, translated, authKey)
+		} else {
+			outcome <- bookSearchOutcome{}
+		}
+	}()
+	return outcome
+}
+
+// translateToKorean translates @query into Korean if it is confidently written in another language.
+// It returns an empty string if the query should not or could not be translated.
+func translateToKorean(ctx context.Context, query, authKey string) string {
+	detected, err := translation.Detect(query).AuthorizeWith(authKey).CollectContext(ctx)
+	if err != nil || detected.Len() == 0 {
+		return ""
+	}
+
+	lang := detected.LanguageInfo[0]
+	if lang.Code == "kr" || lang.Confidence < smartConfidence || !translation.Supports(lang.Code) {
+		return ""
+	}
+
+	translated, err := translation.Translate(query).AuthorizeWith(authKey).From(lang.Code).To("kr").CollectContext(ctx)
+	if err != nil {
+		return ""
+	}
+
+	var sentences []string
+	for _, paragraph := range translated.TranslatedText {
+		sentences = append(sentences, paragraph...)
+	}
+
+	return strings.TrimSpace(strings.Join(sentences, " "))
+}
+
+// SmartBookSearch searches books by @query, and also by its Korean translation
+// if @query is confidently detected to be written in another language.
+//
+// The first pages of both searches are merged, the books of @query first, without duplicated ISBNs.
+// If the detection, the translation or the translated search fails, only the books of @query are returned.
+// Canceling @ctx cancels the requests in flight, and returns the error of @ctx.
+func SmartBookSearch(ctx context.Context, query, authKey string) (results []SmartBookResult, err error) {
+	defer common.WrapError(OpSmartBookSearch, &err)
+
+	if err = ctx.Err(); err != nil {
+		return
+	}
+
+	original, translated := searchBooks(ctx, query, authKey), searchTranslatedBooks(ctx, query, authKey)
+
+	var outcome bookSearchOutcome
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case outcome = <-original:
+	}
+	if outcome.err != nil {
+		return nil, outcome.err
+	}
+
+	seen := map[string]bool{}
+	merge := func(docs []BookResult, query string, translated bool) {
+		for _, doc := range docs {
+			if isbn := isbnOf(doc.ISBN); isbn != "" {
+				if seen[isbn] {
+					continue
+				}
+				seen[isbn] = true
+			}
+			results = append(results, SmartBookResult{BookResult: doc, Query: query, Translated: translated})
+		}
+	}
+	merge(outcome.res.Documents, query, false)
+
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case outcome = <-translated:
+	}
+	if outcome.query != "" && outcome.err == nil {
+		merge(outcome.res.Documents, outcome.query, true)
+	}
+
+	return
+}
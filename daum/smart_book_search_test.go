@@ -0,0 +1,167 @@
+// Copyright 2022 Sanha Maeng, Soyang Baek, Jinmyeong Kim
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 		http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package daum_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/maengsanha/kakao-developers-client/daum"
+	"github.com/maengsanha/kakao-developers-client/internal/mock"
+)
+
+// mockSmartBookSearch serves the language detection of @detected, a Korean translation,
+// and a book search per query, counting the book searches.
+func mockSmartBookSearch(t *testing.T, detected string) (searches *int32) {
+	searches = new(int32)
+
+	books := map[string][]daum.BookResult{
+		"The Devotion of Suspect X": {
+			{ISBN: "0312375069 9780312375065", WebResult: daum.WebResult{Title: "The Devotion of Suspect X"}},
+			{ISBN: "8972756199 9788972756194", WebResult: daum.WebResult{Title: "용의자 X의 헌신"}},
+		},
+		"용의자 X의 헌신": {
+			{ISBN: "8972756199 9788972756194", WebResult: daum.WebResult{Title: "용의자 X의 헌신"}},
+			{ISBN: "8972757497 9788972757498", WebResult: daum.WebResult{Title: "용의자 X의 헌신 (양장)"}},
+		},
+	}
+
+	mock.Kakao(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case strings.HasSuffix(r.URL.Path, "/translation/language/detect"):
+			fmt.Fprint(w, detected)
+		case strings.HasSuffix(r.URL.Path, "/translation/translate"):
+			fmt.Fprint(w, `{"translated_text":[["용의자 X의 헌신"]]}`)
+		case strings.HasSuffix(r.URL.Path, "/search/book"):
+			atomic.AddInt32(searches, 1)
+			fmt.Fprint(w, daum.BookSearchResult{Documents: books[r.URL.Query().Get("query")]})
+		default:
+			t.Errorf("unexpected request to %s", r.URL.Path)
+		}
+	})
+
+	return
+}
+
+func TestSmartBookSearch(t *testing.T) {
+	searches := mockSmartBookSearch(t, `{"language_info":[{"code":"en","name":"English","confidence":0.97}]}`)
+
+	results, err := daum.SmartBookSearch(context.Background(), "The Devotion of Suspect X", testRESTKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []struct {
+		isbn       string
+		translated bool
+	}{
+		{"0312375069 9780312375065", false},
+		{"8972756199 9788972756194", false},
+		{"8972757497 9788972757498", true},
+	}
+	if len(results) != len(want) {
+		t.Fatalf("got %d books, want %d", len(results), len(want))
+	}
+	for idx, result := range results {
+		if result.ISBN != want[idx].isbn || result.Translated != want[idx].translated {
+			t.Errorf("book %d is %s (translated: %v), want %s (translated: %v)",
+				idx, result.ISBN, result.Translated, want[idx].isbn, want[idx].translated)
+		}
+	}
+	if results[2].Query != "용의자 X의 헌신" {
+		t.Errorf("got query %q for a translated book", results[2].Query)
+	}
+	if *searches != 2 {
+		t.Errorf("searched %d times, want 2", *searches)
+	}
+}
+
+func TestSmartBookSearchDegrades(t *testing.T) {
+	for _, detected := range []string{
+		`{"language_info":[{"code":"kr","name":"Korean","confidence":0.97}]}`,
+		`{"language_info":[{"code":"en","name":"English","confidence":0.4}]}`,
+		`{"language_info":[{"code":"xx","name":"Unknown","confidence":0.99}]}`,
+		`not a detection result`,
+	} {
+		t.Run(detected, func(t *testing.T) {
+			searches := mockSmartBookSearch(t, detected)
+
+			results, err := daum.SmartBookSearch(context.Background(), "The Devotion of Suspect X", testRESTKey)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(results) != 2 || results[0].Translated || results[1].Translated {
+				t.Errorf("got %+v, want the books of the original query only", results)
+			}
+			if *searches != 1 {
+				t.Errorf("searched %d times, want 1", *searches)
+			}
+		})
+	}
+}
+
+func TestSmartBookSearchCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	if _, err := daum.SmartBookSearch(ctx, "The Devotion of Suspect X", testRESTKey); !errors.Is(err, context.Canceled) || !strings.HasPrefix(err.Error(), daum.OpSmartBookSearch+": ") {
+		t.Errorf("got %v, want %v prefixed with %s", err, context.Canceled, daum.OpSmartBookSearch)
+	}
+}
+
+func TestSmartBookSearchCanceledDuringDetection(t *testing.T) {
+	detecting, detectCanceled := make(chan struct{}), make(chan struct{})
+	mock.Kakao(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case strings.HasSuffix(r.URL.Path, "/translation/language/detect"):
+			close(detecting)
+			select {
+			case <-r.Context().Done():
+				close(detectCanceled)
+			case <-time.After(10 * time.Second):
+			}
+		case strings.HasSuffix(r.URL.Path, "/search/book"):
+			fmt.Fprint(w, daum.BookSearchResult{})
+		default:
+			t.Errorf("unexpected request to %s", r.URL.Path)
+		}
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		<-detecting
+		cancel()
+	}()
+
+	start := time.Now()
+	if _, err := daum.SmartBookSearch(ctx, "The Devotion of Suspect X", testRESTKey); !errors.Is(err, context.Canceled) || !strings.HasPrefix(err.Error(), daum.OpSmartBookSearch+": ") {
+		t.Errorf("got %v, want %v prefixed with %s", err, context.Canceled, daum.OpSmartBookSearch)
+	}
+	if elapsed := time.Since(start); time.Second < elapsed {
+		t.Errorf("returned after %v, want it to return once canceled", elapsed)
+	}
+
+	select {
+	case <-detectCanceled:
+	case <-time.After(time.Second):
+		t.Error("the detection request was not canceled")
+	}
+}
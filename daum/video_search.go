@@ -59,6 +59,7 @@ type VideoSearchIterator struct {
 	Size    int
 	AuthKey string
 	end     bool
+	offset  common.PageOffset
 }
 
 // VideoSearch allows users to search videos by @query on the video platforms such as Youtube or Kakao TV.
@@ -101,6 +102,7 @@ func (it *VideoSearchIterator) SortBy(order string) *VideoSearchIterator {
 func (it *VideoSearchIterator) Result(page int) *VideoSearchIterator {
 	if 1 <= page && page <= 15 {
 		it.Page = page
+		it.offset.Reset()
 	} else {
 		panic(common.ErrPageOutOfBound)
 	}
@@ -125,15 +127,21 @@ func (it *VideoSearchIterator) Display(size int) *VideoSearchIterator {
 
 // Next returns the video search result and proceeds the iterator to the next page.
 func (it *VideoSearchIterator) Next() (res VideoSearchResult, err error) {
+	defer common.WrapError(OpVideoSearch, &err)
+
 	if it.end {
 		return res, Done
 	}
 
-	client := &http.Client{}
+	var skip int
+	if it.Page, skip = it.offset.Realign(it.Page, it.Size); 15 < it.Page {
+		it.end = true
+		return res, Done
+	}
 
 	req, err := http.NewRequest(http.MethodGet,
-		fmt.Sprintf("%svclip?query=%s&sort=%s&page=%d&size=%d",
-			prefix, it.Query, it.Sort, it.Page, it.Size), nil)
+		fmt.Sprintf("%s?query=%s&sort=%s&page=%d&size=%d",
+			endpoint("vclip"), it.Query, it.Sort, it.Page, it.Size), nil)
 
 	if err != nil {
 		return
@@ -143,7 +151,7 @@ func (it *VideoSearchIterator) Next() (res VideoSearchResult, err error) {
 
 	req.Header.Set(common.Authorization, it.AuthKey)
 
-	resp, err := client.Do(req)
+	resp, err := common.Do(Service, req)
 	if err != nil {
 		return
 	}
@@ -154,6 +162,9 @@ func (it *VideoSearchIterator) Next() (res VideoSearchResult, err error) {
 		return
 	}
 
+	res.Documents = res.Documents[common.Skip(skip, len(res.Documents)):]
+	it.offset.Advance(it.Page, it.Size)
+
 	it.end = res.Meta.IsEnd || 15 < it.Page
 
 	it.Page++
@@ -0,0 +1,235 @@
+// Copyright 2022 Sanha Maeng, Soyang Baek, Jinmyeong Kim
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 		http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// CircuitState represents the state of the circuit breaker of a service.
+type CircuitState int
+
+const (
+	// CircuitClosed lets every request through.
+	CircuitClosed CircuitState = iota
+	// CircuitOpen fails every request with ErrCircuitOpen until the cool-down ends.
+	CircuitOpen
+	// CircuitHalfOpen lets a single probe through, whose outcome closes or reopens the circuit.
+	// Another probe is let through if the probe is canceled, or left unanswered for the cool-down.
+	CircuitHalfOpen
+)
+
+// String implements fmt.Stringer.
+func (cs CircuitState) String() string {
+	switch cs {
+	case CircuitOpen:
+		return "open"
+	case CircuitHalfOpen:
+		return "half-open"
+	default:
+		return "closed"
+	}
+}
+
+// CircuitBreakerConfig configures the circuit breaker of a service.
+type CircuitBreakerConfig struct {
+	// Failures is the number of consecutive failures opening the circuit.
+	// A connection error or a 5xx response is a failure, but a request canceled by its context is not.
+	Failures int
+	// CoolDown is how long the circuit stays open before letting a probe through, as told by the clock set by SetClock.
+	CoolDown time.Duration
+	// OnStateChange, if not nil, is called on each change of the state of the circuit.
+	OnStateChange func(service string, from, to CircuitState)
+}
+
+type breaker struct {
+	service  string
+	cfg      CircuitBreakerConfig
+	mu       sync.Mutex
+	state    CircuitState
+	failures int
+	openedAt time.Time
+	// probing tells whether the probe of a half-open circuit, sent at probedAt, is in flight.
+	probing  bool
+	probedAt time.Time
+	// generation counts the changes of state and the replaced probes, so that only
+	// the outcomes of the requests let through in the current state are recorded.
+	generation uint64
+}
+
+var (
+	breakersMu sync.RWMutex
+	breakers   = map[string]*breaker{}
+)
+
+// SetCircuitBreaker sets the circuit breaker of @service, such as daum, local, translation, vision or pose,
+// starting from a closed circuit.
+//
+// The circuit breakers are disabled by default, and @cfg with no Failures disables the one of @service.
+func SetCircuitBreaker(service string, cfg CircuitBreakerConfig) {
+	breakersMu.Lock()
+	defer breakersMu.Unlock()
+
+	if cfg.Failures <= 0 {
+		delete(breakers, service)
+	} else {
+		breakers[service] = &breaker{service: service, cfg: cfg}
+	}
+}
+
+// CircuitStateOf returns the state of the circuit of @service, which is closed if it has no circuit breaker.
+func CircuitStateOf(service string) CircuitState {
+	if b := breakerOf(service); b != nil {
+		b.mu.Lock()
+		defer b.mu.Unlock()
+		return b.state
+	}
+	return CircuitClosed
+}
+
+func breakerOf(service string) *breaker {
+	breakersMu.RLock()
+	defer breakersMu.RUnlock()
+	return breakers[service]
+}
+
+// Do sends @req through the circuit breaker of @service.
+//
+// Do fails without sending @req with ErrCredentialKindMismatch if its credential is not of the kind
+// its authorization header asks for, and with ErrCircuitOpen while the circuit is open.
+func Do(service string, req *http.Request) (*http.Response, error) {
+	if err := checkAuthorization(req.Header.Get(Authorization)); err != nil {
+		if req.Body != nil {
+			req.Body.Close()
+		}
+		return nil, err
+	}
+
+	b := breakerOf(service)
+	if b == nil {
+		return (&http.Client{}).Do(req)
+	}
+
+	generation, ok := b.allow()
+	if !ok {
+		if req.Body != nil {
+			req.Body.Close()
+		}
+		return nil, ErrCircuitOpen
+	}
+
+	resp, err := (&http.Client{}).Do(req)
+	if errors.Is(err, context.Canceled) {
+		// the caller gave up on the request, which tells nothing about the service
+		b.abandon(generation)
+	} else {
+		b.done(generation, err == nil && resp.StatusCode < http.StatusInternalServerError)
+	}
+	return resp, err
+}
+
+// allow reports whether a request can be sent, along with the generation of the state it is sent in.
+//
+// Once the cool-down ends, a single probe is let through in a new half-open state.
+// A new probe replaces the one in flight once it is abandoned or has gone unanswered for the cool-down.
+func (b *breaker) allow() (generation uint64, ok bool) {
+	b.mu.Lock()
+	notify := func() {}
+	defer func() {
+		b.mu.Unlock()
+		notify()
+	}()
+
+	switch b.state {
+	case CircuitOpen:
+		if Now().Sub(b.openedAt) < b.cfg.CoolDown {
+			return b.generation, false
+		}
+		notify = b.transition(CircuitHalfOpen)
+		b.probing, b.probedAt = true, Now()
+		return b.generation, true
+	case CircuitHalfOpen:
+		if b.probing && Now().Sub(b.probedAt) < b.cfg.CoolDown {
+			return b.generation, false
+		}
+		// a new generation ignores the outcome of the probe it replaces
+		b.generation++
+		b.probing, b.probedAt = true, Now()
+		return b.generation, true
+	default:
+		return b.generation, true
+	}
+}
+
+// done records the outcome of a request let through by allow in @generation.
+//
+// The outcomes of the requests sent before the last change of state are ignored,
+// so that only the probe decides on a half-open circuit.
+func (b *breaker) done(generation uint64, ok bool) {
+	b.mu.Lock()
+	notify := func() {}
+	defer func() {
+		b.mu.Unlock()
+		notify()
+	}()
+
+	if generation != b.generation {
+		return
+	}
+
+	switch {
+	case b.state == CircuitHalfOpen:
+		if ok {
+			notify = b.transition(CircuitClosed)
+		} else {
+			notify = b.transition(CircuitOpen)
+		}
+	case ok:
+		b.failures = 0
+	default:
+		if b.failures++; b.cfg.Failures <= b.failures {
+			notify = b.transition(CircuitOpen)
+		}
+	}
+}
+
+// abandon records that a request let through by allow in @generation was canceled,
+// freeing a half-open circuit for another probe if it was the probe.
+func (b *breaker) abandon(generation uint64) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if generation == b.generation && b.state == CircuitHalfOpen {
+		b.probing = false
+	}
+}
+
+// transition changes the state of b to @to, returning the call to the hook to make once b is unlocked.
+func (b *breaker) transition(to CircuitState) func() {
+	from := b.state
+	b.state, b.failures, b.generation = to, 0, b.generation+1
+	if to == CircuitOpen {
+		b.openedAt = Now()
+	}
+
+	if hook := b.cfg.OnStateChange; hook != nil && from != to {
+		return func() { hook(b.service, from, to) }
+	}
+	return func() {}
+}
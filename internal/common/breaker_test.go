@@ -0,0 +1,386 @@
+// Copyright 2022 Sanha Maeng, Soyang Baek, Jinmyeong Kim
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 		http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+)
+
+// scriptedServer responds with @statuses in order, counting the requests it receives.
+func scriptedServer(t *testing.T, statuses ...int) (url string, requests func() int) {
+	t.Helper()
+
+	var (
+		mu    sync.Mutex
+		count int
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		w.WriteHeader(statuses[count])
+		count++
+	}))
+	t.Cleanup(server.Close)
+
+	return server.URL, func() int {
+		mu.Lock()
+		defer mu.Unlock()
+		return count
+	}
+}
+
+func send(t *testing.T, service, url string) error {
+	t.Helper()
+	return sendContext(t, context.Background(), service, url)
+}
+
+func sendContext(t *testing.T, ctx context.Context, service, url string) error {
+	t.Helper()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err := Do(service, req)
+	if err == nil {
+		resp.Body.Close()
+	}
+	return err
+}
+
+func TestCircuitBreakerLifecycle(t *testing.T) {
+	const service = "lifecycle"
+
+	clock := NewFakeClock(time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC))
+	SetClock(clock)
+	t.Cleanup(func() { SetClock(RealClock{}) })
+
+	var transitions []string
+	SetCircuitBreaker(service, CircuitBreakerConfig{
+		Failures: 2,
+		CoolDown: 50 * time.Millisecond,
+		OnStateChange: func(service string, from, to CircuitState) {
+			transitions = append(transitions, fmt.Sprintf("%s->%s", from, to))
+		},
+	})
+	t.Cleanup(func() { SetCircuitBreaker(service, CircuitBreakerConfig{}) })
+
+	url, requests := scriptedServer(t, 500, 500, 503, 200, 200)
+
+	// two consecutive failures open the circuit
+	for idx := 0; idx < 2; idx++ {
+		if err := send(t, service, url); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if state := CircuitStateOf(service); state != CircuitOpen {
+		t.Fatalf("got %s, want %s", state, CircuitOpen)
+	}
+	if err := send(t, service, url); err != ErrCircuitOpen {
+		t.Fatalf("got %v, want %v", err, ErrCircuitOpen)
+	}
+
+	// a failing probe reopens the circuit
+	clock.Advance(49 * time.Millisecond)
+	if err := send(t, service, url); err != ErrCircuitOpen {
+		t.Fatalf("got %v before the cool-down ends, want %v", err, ErrCircuitOpen)
+	}
+	clock.Advance(time.Millisecond)
+	if err := send(t, service, url); err != nil {
+		t.Fatal(err)
+	}
+	if err := send(t, service, url); err != ErrCircuitOpen {
+		t.Fatalf("got %v, want %v", err, ErrCircuitOpen)
+	}
+
+	// a succeeding probe closes it
+	clock.Advance(50 * time.Millisecond)
+	for idx := 0; idx < 2; idx++ {
+		if err := send(t, service, url); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if state := CircuitStateOf(service); state != CircuitClosed {
+		t.Errorf("got %s, want %s", state, CircuitClosed)
+	}
+
+	if got := requests(); got != 5 {
+		t.Errorf("got %d requests, want 5", got)
+	}
+	want := []string{"closed->open", "open->half-open", "half-open->open", "open->half-open", "half-open->closed"}
+	if !reflect.DeepEqual(transitions, want) {
+		t.Errorf("got %v, want %v", transitions, want)
+	}
+}
+
+func TestCircuitBreakerConnectionError(t *testing.T) {
+	const service = "connection"
+
+	SetCircuitBreaker(service, CircuitBreakerConfig{Failures: 1, CoolDown: time.Hour})
+	t.Cleanup(func() { SetCircuitBreaker(service, CircuitBreakerConfig{}) })
+
+	server := httptest.NewServer(http.NotFoundHandler())
+	server.Close()
+
+	if err := send(t, service, server.URL); err == nil || err == ErrCircuitOpen {
+		t.Fatalf("got %v, want a connection error", err)
+	}
+	if err := send(t, service, server.URL); err != ErrCircuitOpen {
+		t.Errorf("got %v, want %v", err, ErrCircuitOpen)
+	}
+}
+
+func TestCircuitBreakerDisabled(t *testing.T) {
+	const service = "disabled"
+
+	url, requests := scriptedServer(t, 500, 500, 500, 200, 200)
+
+	for idx := 0; idx < 3; idx++ {
+		if err := send(t, service, url); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// no failures disable the breaker, closing the circuit
+	SetCircuitBreaker(service, CircuitBreakerConfig{Failures: 1, CoolDown: time.Hour})
+	SetCircuitBreaker(service, CircuitBreakerConfig{})
+	for idx := 0; idx < 2; idx++ {
+		if err := send(t, service, url); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if got := requests(); got != 5 {
+		t.Errorf("got %d requests, want 5", got)
+	}
+	if state := CircuitStateOf(service); state != CircuitClosed {
+		t.Errorf("got %s, want %s", state, CircuitClosed)
+	}
+}
+
+func TestCircuitBreakerIgnoresStaleRequests(t *testing.T) {
+	const service = "stale"
+
+	clock := NewFakeClock(time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC))
+	SetClock(clock)
+	t.Cleanup(func() { SetClock(RealClock{}) })
+
+	var transitions []string
+	SetCircuitBreaker(service, CircuitBreakerConfig{
+		Failures: 1,
+		CoolDown: time.Minute,
+		OnStateChange: func(service string, from, to CircuitState) {
+			transitions = append(transitions, fmt.Sprintf("%s->%s", from, to))
+		},
+	})
+	t.Cleanup(func() { SetCircuitBreaker(service, CircuitBreakerConfig{}) })
+
+	var (
+		received             = make(chan string)
+		releaseSlow, release = make(chan struct{}), make(chan struct{})
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/slow":
+			received <- r.URL.Path
+			<-releaseSlow
+		case "/probe":
+			received <- r.URL.Path
+			<-release
+			w.WriteHeader(http.StatusInternalServerError)
+		default:
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	sent := func(path string) <-chan error {
+		errs := make(chan error, 1)
+		go func() { errs <- send(t, service, server.URL+path) }()
+		<-received
+		return errs
+	}
+
+	// a request let through while the circuit is closed outlives the circuit opening
+	slow := sent("/slow")
+	if err := send(t, service, server.URL+"/fail"); err != nil {
+		t.Fatal(err)
+	}
+
+	clock.Advance(time.Minute)
+	probe := sent("/probe")
+
+	// the slow request succeeds while the probe is in flight, but does not close the circuit
+	close(releaseSlow)
+	if err := <-slow; err != nil {
+		t.Fatal(err)
+	}
+	if state := CircuitStateOf(service); state != CircuitHalfOpen {
+		t.Errorf("got %s, want %s", state, CircuitHalfOpen)
+	}
+	if err := send(t, service, server.URL+"/fail"); err != ErrCircuitOpen {
+		t.Errorf("got %v while the probe is in flight, want %v", err, ErrCircuitOpen)
+	}
+
+	// the probe fails, reopening the circuit
+	close(release)
+	if err := <-probe; err != nil {
+		t.Fatal(err)
+	}
+	if state := CircuitStateOf(service); state != CircuitOpen {
+		t.Errorf("got %s, want %s", state, CircuitOpen)
+	}
+
+	want := []string{"closed->open", "open->half-open", "half-open->open"}
+	if !reflect.DeepEqual(transitions, want) {
+		t.Errorf("got %v, want %v", transitions, want)
+	}
+}
+
+func TestCircuitBreakerIgnoresCanceledRequests(t *testing.T) {
+	const service = "canceled"
+
+	clock := NewFakeClock(time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC))
+	SetClock(clock)
+	t.Cleanup(func() { SetClock(RealClock{}) })
+
+	var transitions []string
+	SetCircuitBreaker(service, CircuitBreakerConfig{
+		Failures: 1,
+		CoolDown: time.Minute,
+		OnStateChange: func(service string, from, to CircuitState) {
+			transitions = append(transitions, fmt.Sprintf("%s->%s", from, to))
+		},
+	})
+	t.Cleanup(func() { SetCircuitBreaker(service, CircuitBreakerConfig{}) })
+
+	url, requests := scriptedServer(t, 500, 200)
+
+	canceled, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	// a canceled request does not open the circuit
+	if err := sendContext(t, canceled, service, url); !errors.Is(err, context.Canceled) {
+		t.Fatalf("got %v, want %v", err, context.Canceled)
+	}
+	if state := CircuitStateOf(service); state != CircuitClosed {
+		t.Fatalf("got %s, want %s", state, CircuitClosed)
+	}
+	if err := send(t, service, url); err != nil {
+		t.Fatal(err)
+	}
+
+	// a canceled probe neither reopens the circuit nor holds it half-open
+	clock.Advance(time.Minute)
+	if err := sendContext(t, canceled, service, url); !errors.Is(err, context.Canceled) {
+		t.Fatalf("got %v, want %v", err, context.Canceled)
+	}
+	if state := CircuitStateOf(service); state != CircuitHalfOpen {
+		t.Fatalf("got %s, want %s", state, CircuitHalfOpen)
+	}
+	if err := send(t, service, url); err != nil {
+		t.Fatal(err)
+	}
+
+	if got := requests(); got != 2 {
+		t.Errorf("got %d requests, want 2", got)
+	}
+	want := []string{"closed->open", "open->half-open", "half-open->closed"}
+	if !reflect.DeepEqual(transitions, want) {
+		t.Errorf("got %v, want %v", transitions, want)
+	}
+}
+
+func TestCircuitBreakerReplacesUnansweredProbe(t *testing.T) {
+	const service = "unanswered"
+
+	clock := NewFakeClock(time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC))
+	SetClock(clock)
+	t.Cleanup(func() { SetClock(RealClock{}) })
+
+	var transitions []string
+	SetCircuitBreaker(service, CircuitBreakerConfig{
+		Failures: 1,
+		CoolDown: time.Minute,
+		OnStateChange: func(service string, from, to CircuitState) {
+			transitions = append(transitions, fmt.Sprintf("%s->%s", from, to))
+		},
+	})
+	t.Cleanup(func() { SetCircuitBreaker(service, CircuitBreakerConfig{}) })
+
+	var (
+		received, release = make(chan struct{}), make(chan struct{})
+		releaseOnce       sync.Once
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/hang":
+			received <- struct{}{}
+			<-release
+			w.WriteHeader(http.StatusInternalServerError)
+		case "/fail":
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	}))
+	t.Cleanup(server.Close)
+	// lets the server close if the test fails before the hung probe is answered
+	t.Cleanup(func() { releaseOnce.Do(func() { close(release) }) })
+
+	if err := send(t, service, server.URL+"/fail"); err != nil {
+		t.Fatal(err)
+	}
+
+	// the probe hangs
+	clock.Advance(time.Minute)
+	hung := make(chan error, 1)
+	go func() { hung <- send(t, service, server.URL+"/hang") }()
+	<-received
+
+	clock.Advance(time.Minute - time.Millisecond)
+	if err := send(t, service, server.URL+"/ok"); err != ErrCircuitOpen {
+		t.Errorf("got %v while the probe is in flight, want %v", err, ErrCircuitOpen)
+	}
+
+	// another probe is let through a cool-down after it, and closes the circuit
+	clock.Advance(time.Millisecond)
+	if err := send(t, service, server.URL+"/ok"); err != nil {
+		t.Fatal(err)
+	}
+	if state := CircuitStateOf(service); state != CircuitClosed {
+		t.Errorf("got %s, want %s", state, CircuitClosed)
+	}
+
+	// the failure of the replaced probe is ignored
+	releaseOnce.Do(func() { close(release) })
+	if err := <-hung; err != nil {
+		t.Fatal(err)
+	}
+	if state := CircuitStateOf(service); state != CircuitClosed {
+		t.Errorf("got %s, want %s", state, CircuitClosed)
+	}
+
+	want := []string{"closed->open", "open->half-open", "half-open->closed"}
+	if !reflect.DeepEqual(transitions, want) {
+		t.Errorf("got %v, want %v", transitions, want)
+	}
+}
@@ -0,0 +1,181 @@
+// Copyright 2022 Sanha Maeng, Soyang Baek, Jinmyeong Kim
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 		http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"sync"
+	"time"
+)
+
+// Clock tells and waits for the time, so that time-dependent behavior can be tested without waiting.
+type Clock interface {
+	Now() time.Time
+	Sleep(d time.Duration)
+	NewTicker(d time.Duration) Ticker
+	After(d time.Duration) <-chan time.Time
+}
+
+// Ticker delivers ticks of a Clock at intervals.
+type Ticker interface {
+	C() <-chan time.Time
+	Stop()
+}
+
+var (
+	clockMu sync.RWMutex
+	clock   Clock = RealClock{}
+)
+
+// SetClock sets the clock of the time-dependent behavior of the library, such as circuit breaker cool-downs.
+func SetClock(c Clock) {
+	clockMu.Lock()
+	defer clockMu.Unlock()
+	clock = c
+}
+
+// Now returns the current time of the clock set by SetClock.
+func Now() time.Time {
+	clockMu.RLock()
+	defer clockMu.RUnlock()
+	return clock.Now()
+}
+
+// RealClock is the Clock of the time package, which is the default one.
+type RealClock struct{}
+
+// Now implements Clock.
+func (RealClock) Now() time.Time { return time.Now() }
+
+// Sleep implements Clock.
+func (RealClock) Sleep(d time.Duration) { time.Sleep(d) }
+
+// NewTicker implements Clock.
+func (RealClock) NewTicker(d time.Duration) Ticker { return realTicker{time.NewTicker(d)} }
+
+// After implements Clock.
+func (RealClock) After(d time.Duration) <-chan time.Time { return time.After(d) }
+
+type realTicker struct{ *time.Ticker }
+
+func (rt realTicker) C() <-chan time.Time { return rt.Ticker.C }
+
+// FakeClock is a Clock which only moves forward by Advance.
+//
+// A FakeClock is safe for concurrent use.
+type FakeClock struct {
+	mu      sync.Mutex
+	now     time.Time
+	waiters []*fakeWaiter
+}
+
+type fakeWaiter struct {
+	at     time.Time
+	period time.Duration
+	c      chan time.Time
+}
+
+// NewFakeClock returns a FakeClock starting at @start.
+func NewFakeClock(start time.Time) *FakeClock { return &FakeClock{now: start} }
+
+// Now implements Clock.
+func (fc *FakeClock) Now() time.Time {
+	fc.mu.Lock()
+	defer fc.mu.Unlock()
+	return fc.now
+}
+
+// Sleep implements Clock, blocking until the clock advances by @d.
+func (fc *FakeClock) Sleep(d time.Duration) { <-fc.After(d) }
+
+// After implements Clock.
+func (fc *FakeClock) After(d time.Duration) <-chan time.Time {
+	return fc.wait(d, 0).c
+}
+
+// NewTicker implements Clock. It panics if @d is not positive, as time.NewTicker does.
+func (fc *FakeClock) NewTicker(d time.Duration) Ticker {
+	if d <= 0 {
+		panic("non-positive interval for NewTicker")
+	}
+	return &fakeTicker{fc, fc.wait(d, d)}
+}
+
+func (fc *FakeClock) wait(d, period time.Duration) *fakeWaiter {
+	fc.mu.Lock()
+	defer fc.mu.Unlock()
+
+	w := &fakeWaiter{at: fc.now.Add(d), period: period, c: make(chan time.Time, 1)}
+	if d <= 0 {
+		w.c <- fc.now
+	} else {
+		fc.waiters = append(fc.waiters, w)
+	}
+	return w
+}
+
+// Advance moves the clock forward by @d, firing the timers and tickers due by then.
+//
+// As with time.Ticker, a ticker drops the ticks its reader is not ready for.
+func (fc *FakeClock) Advance(d time.Duration) {
+	fc.mu.Lock()
+	defer fc.mu.Unlock()
+
+	fc.now = fc.now.Add(d)
+
+	waiters := fc.waiters[:0]
+	for _, w := range fc.waiters {
+		for !w.at.After(fc.now) {
+			select {
+			case w.c <- w.at:
+			default:
+			}
+			if w.period == 0 {
+				break
+			}
+			w.at = w.at.Add(w.period)
+		}
+		if w.at.After(fc.now) {
+			waiters = append(waiters, w)
+		}
+	}
+	fc.waiters = waiters
+}
+
+// Waiters returns the number of pending timers and tickers, including the ones of Sleep,
+// so that a test can wait for a goroutine to start waiting before advancing the clock.
+func (fc *FakeClock) Waiters() int {
+	fc.mu.Lock()
+	defer fc.mu.Unlock()
+	return len(fc.waiters)
+}
+
+type fakeTicker struct {
+	fc *FakeClock
+	w  *fakeWaiter
+}
+
+func (ft *fakeTicker) C() <-chan time.Time { return ft.w.c }
+
+func (ft *fakeTicker) Stop() {
+	ft.fc.mu.Lock()
+	defer ft.fc.mu.Unlock()
+
+	for idx, w := range ft.fc.waiters {
+		if w == ft.w {
+			ft.fc.waiters = append(ft.fc.waiters[:idx], ft.fc.waiters[idx+1:]...)
+			return
+		}
+	}
+}
@@ -0,0 +1,90 @@
+// Copyright 2022 Sanha Maeng, Soyang Baek, Jinmyeong Kim
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 		http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"testing"
+	"time"
+)
+
+var epoch = time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+
+func TestFakeClockAfter(t *testing.T) {
+	clock := NewFakeClock(epoch)
+	after := clock.After(time.Second)
+
+	clock.Advance(999 * time.Millisecond)
+	select {
+	case at := <-after:
+		t.Fatalf("fired at %v before the deadline", at)
+	default:
+	}
+
+	clock.Advance(time.Millisecond)
+	if at := <-after; !at.Equal(epoch.Add(time.Second)) {
+		t.Errorf("got %v, want %v", at, epoch.Add(time.Second))
+	}
+	if got := clock.Now(); !got.Equal(epoch.Add(time.Second)) {
+		t.Errorf("got %v, want %v", got, epoch.Add(time.Second))
+	}
+	if n := clock.Waiters(); n != 0 {
+		t.Errorf("got %d waiters, want 0", n)
+	}
+}
+
+func TestFakeClockTicker(t *testing.T) {
+	clock := NewFakeClock(epoch)
+	ticker := clock.NewTicker(time.Minute)
+
+	for idx := 1; idx <= 3; idx++ {
+		clock.Advance(time.Minute)
+		if at, want := <-ticker.C(), epoch.Add(time.Duration(idx)*time.Minute); !at.Equal(want) {
+			t.Errorf("got %v, want %v", at, want)
+		}
+	}
+
+	// the ticks not read are dropped
+	clock.Advance(3 * time.Minute)
+	<-ticker.C()
+	select {
+	case at := <-ticker.C():
+		t.Errorf("got a dropped tick at %v", at)
+	default:
+	}
+
+	ticker.Stop()
+	clock.Advance(time.Minute)
+	select {
+	case at := <-ticker.C():
+		t.Errorf("got a tick at %v after Stop", at)
+	default:
+	}
+}
+
+func TestFakeClockSleep(t *testing.T) {
+	clock := NewFakeClock(epoch)
+
+	done := make(chan struct{})
+	go func() {
+		clock.Sleep(time.Hour)
+		close(done)
+	}()
+
+	for clock.Waiters() == 0 {
+		time.Sleep(time.Millisecond)
+	}
+	clock.Advance(time.Hour)
+	<-done
+}
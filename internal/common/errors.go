@@ -14,7 +14,10 @@
 
 package common
 
-import "errors"
+import (
+	"errors"
+	"fmt"
+)
 
 var (
 	ErrUnsupportedFormat       = errors.New("unsupported format")
@@ -23,4 +26,18 @@ var (
 	ErrEndPage                 = errors.New("page reaches the end")
 	ErrUnsupportedSortingOrder = errors.New("unsupported sorting order")
 	ErrTooLargeFile            = errors.New("file size exceeds limit")
+	ErrCredentialKindMismatch  = errors.New("credential kind mismatch")
+	ErrTruncatedResponse       = errors.New("response is truncated")
+	ErrCircuitOpen             = errors.New("circuit is open")
+	ErrUnsupportedAPIVersion   = errors.New("unsupported API version")
 )
+
+// WrapError prefixes the error *@err with the operation name @op,
+// keeping it reachable by errors.Is and errors.As.
+//
+// ErrEndPage is kept as is, so that iterators can still be compared with Done.
+func WrapError(op string, err *error) {
+	if *err != nil && *err != ErrEndPage {
+		*err = fmt.Errorf("%s: %w", op, *err)
+	}
+}
@@ -1,3 +1,5 @@
 module common
 
 go 1.17
+
+require github.com/goccy/go-json v0.9.5
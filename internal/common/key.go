@@ -14,13 +14,93 @@
 
 package common
 
-import "strings"
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"sync/atomic"
+)
 
 const (
 	Authorization = "Authorization"
 	KeyPrefix     = "KakaoAK "
+	TokenPrefix   = "Bearer "
 	REST_API_KEY  = "" // paste your REST API key here
 )
 
+const (
+	appKeyKind      = "REST API or admin key"
+	accessTokenKind = "access token"
+	unknownKind     = "unrecognized credential"
+)
+
+var (
+	// appKey matches REST API keys and admin keys, which are 32 lowercase hex characters.
+	appKey = regexp.MustCompile(`^[0-9a-f]{32}$`)
+	// accessToken matches OAuth access tokens, which are longer and use URL-safe characters.
+	accessToken = regexp.MustCompile(`^[A-Za-z0-9_-]{40,}$`)
+
+	anyCredential int32
+)
+
+// AllowAnyCredential turns the credential kind checks off if @allow is true, and back on otherwise.
+func AllowAnyCredential(allow bool) {
+	if allow {
+		atomic.StoreInt32(&anyCredential, 1)
+	} else {
+		atomic.StoreInt32(&anyCredential, 0)
+	}
+}
+
+func kindOf(credential string) string {
+	switch {
+	case appKey.MatchString(credential):
+		return appKeyKind
+	case accessToken.MatchString(credential):
+		return accessTokenKind
+	default:
+		return unknownKind
+	}
+}
+
+// checkKind returns ErrCredentialKindMismatch if @credential does not look like @expected.
+//
+// Empty credentials are left to the API server to reject.
+func checkKind(credential, expected string) error {
+	if credential == "" || atomic.LoadInt32(&anyCredential) == 1 {
+		return nil
+	}
+	if kind := kindOf(credential); kind != expected {
+		return fmt.Errorf("%w: expected %s, got %s", ErrCredentialKindMismatch, expected, kind)
+	}
+	return nil
+}
+
+// CheckKey returns ErrCredentialKindMismatch if @key does not look like a REST API or admin key.
+func CheckKey(key string) error { return checkKind(strings.TrimSpace(key), appKeyKind) }
+
+// CheckToken returns ErrCredentialKindMismatch if @token does not look like an access token.
+func CheckToken(token string) error { return checkKind(strings.TrimSpace(token), accessTokenKind) }
+
+// checkAuthorization checks the credential of the authorization header value @auth
+// against the kind its prefix stands for.
+func checkAuthorization(auth string) error {
+	switch {
+	case strings.HasPrefix(auth, KeyPrefix):
+		return CheckKey(auth[len(KeyPrefix):])
+	case strings.HasPrefix(auth, TokenPrefix):
+		return CheckToken(auth[len(TokenPrefix):])
+	default:
+		return nil
+	}
+}
+
 // FormatKey formats @key to Kakao Developers' authorization key format.
+//
+// The kind of @key is checked by CheckKey when a request is sent with it.
 func FormatKey(key string) string { return KeyPrefix + strings.TrimSpace(key) }
+
+// FormatToken formats @token to the authorization format of the APIs which require user login.
+//
+// The kind of @token is checked by CheckToken when a request is sent with it.
+func FormatToken(token string) string { return TokenPrefix + strings.TrimSpace(token) }
@@ -0,0 +1,125 @@
+// Copyright 2022 Sanha Maeng, Soyang Baek, Jinmyeong Kim
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 		http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+const (
+	testKey   = "0123456789abcdef0123456789abcdef"
+	testToken = "Xq3vYb_0aFzE-9kTn2LdPw7RsUc4HmJgKe1BoAi6Nt8Vy5WxZl"
+)
+
+func TestCheckKey(t *testing.T) {
+	for _, key := range []string{testKey, " " + testKey + "\n", ""} {
+		if err := CheckKey(key); err != nil {
+			t.Errorf("got %v for %q, want no error", err, key)
+		}
+	}
+
+	err := CheckKey(testToken)
+	if !errors.Is(err, ErrCredentialKindMismatch) {
+		t.Fatalf("got %v, want %v", err, ErrCredentialKindMismatch)
+	}
+	if !strings.Contains(err.Error(), appKeyKind) || !strings.Contains(err.Error(), accessTokenKind) {
+		t.Errorf("%q should name both credential kinds", err)
+	}
+
+	if err := CheckKey("not a key"); !errors.Is(err, ErrCredentialKindMismatch) {
+		t.Errorf("got %v, want %v", err, ErrCredentialKindMismatch)
+	}
+}
+
+func TestCheckToken(t *testing.T) {
+	if err := CheckToken(testToken); err != nil {
+		t.Errorf("got %v, want no error", err)
+	}
+
+	err := CheckToken(testKey)
+	if !errors.Is(err, ErrCredentialKindMismatch) {
+		t.Fatalf("got %v, want %v", err, ErrCredentialKindMismatch)
+	}
+	if !strings.Contains(err.Error(), appKeyKind) || !strings.Contains(err.Error(), accessTokenKind) {
+		t.Errorf("%q should name both credential kinds", err)
+	}
+}
+
+func TestFormatKey(t *testing.T) {
+	if got := FormatKey(" " + testKey + "\n"); got != KeyPrefix+testKey {
+		t.Errorf("got %q, want %q", got, KeyPrefix+testKey)
+	}
+	if got := FormatToken(testToken); got != TokenPrefix+testToken {
+		t.Errorf("got %q, want %q", got, TokenPrefix+testToken)
+	}
+
+	// formatting never fails, the kind is checked when sending the request
+	if got := FormatKey(testToken); got != KeyPrefix+testToken {
+		t.Errorf("got %q, want %q", got, KeyPrefix+testToken)
+	}
+}
+
+func TestDoChecksCredentialKind(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) { requests++ }))
+	defer server.Close()
+
+	for _, tc := range []struct {
+		auth     string
+		mismatch bool
+	}{
+		{FormatKey(testKey), false},
+		{FormatKey(testToken), true},
+		{FormatToken(testToken), false},
+		{FormatToken(testKey), true},
+		{FormatKey(""), false},
+	} {
+		req, _ := http.NewRequest(http.MethodGet, server.URL, nil)
+		req.Header.Set(Authorization, tc.auth)
+
+		resp, err := Do("credential", req)
+		if err == nil {
+			resp.Body.Close()
+		}
+		if errors.Is(err, ErrCredentialKindMismatch) != tc.mismatch {
+			t.Errorf("got %v for %q, want a mismatch: %v", err, tc.auth, tc.mismatch)
+		}
+	}
+
+	if requests != 3 {
+		t.Errorf("got %d requests, want the 3 with matching credentials", requests)
+	}
+}
+
+func TestAllowAnyCredential(t *testing.T) {
+	AllowAnyCredential(true)
+
+	if err := CheckKey(testToken); err != nil {
+		t.Errorf("got %v, want no error", err)
+	}
+	if err := CheckToken(testKey); err != nil {
+		t.Errorf("got %v, want no error", err)
+	}
+
+	AllowAnyCredential(false)
+
+	if err := CheckKey(testToken); !errors.Is(err, ErrCredentialKindMismatch) {
+		t.Errorf("got %v, want %v once the checks are back on", err, ErrCredentialKindMismatch)
+	}
+}
@@ -0,0 +1,117 @@
+// Copyright 2022 Sanha Maeng, Soyang Baek, Jinmyeong Kim
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 		http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// TruncatedResponseError is returned by lenient decoding when the response ends unexpectedly.
+type TruncatedResponseError struct {
+	// Offset is the number of bytes of the response decoded before the truncation.
+	Offset int64
+}
+
+func (e *TruncatedResponseError) Error() string {
+	return fmt.Sprintf("%s at byte %d", ErrTruncatedResponse, e.Offset)
+}
+
+// Unwrap makes errors.Is(err, ErrTruncatedResponse) hold.
+func (e *TruncatedResponseError) Unwrap() error { return ErrTruncatedResponse }
+
+// eofReader records how many bytes it read and whether its reader reached the end.
+type eofReader struct {
+	io.Reader
+	n   int64
+	eof bool
+}
+
+func (r *eofReader) Read(p []byte) (n int, err error) {
+	n, err = r.Reader.Read(p)
+	r.n += int64(n)
+	r.eof = r.eof || err == io.EOF
+	return
+}
+
+// DecodeLenient decodes the JSON object of @r member by member,
+// so that the members decoded before a truncation are kept.
+//
+// The members named in @members are decoded into their values, and the arrays named in @elements are
+// decoded element by element, calling their callbacks once per element with the function decoding it.
+// The other members are skipped.
+// If @r ends before the object does, it returns a *TruncatedResponseError.
+func DecodeLenient(r io.Reader, members map[string]interface{}, elements map[string]func(decode func(interface{}) error) error) error {
+	body := &eofReader{Reader: r}
+	dec := json.NewDecoder(body)
+
+	// the response ended too early if the decoder ran out of input,
+	// as opposed to finding an invalid character before the end
+	truncated := func(err error) error {
+		var syntax *json.SyntaxError
+		if err == io.EOF || errors.Is(err, io.ErrUnexpectedEOF) ||
+			(body.eof && errors.As(err, &syntax) && body.n <= syntax.Offset) {
+			return &TruncatedResponseError{Offset: dec.InputOffset()}
+		}
+		return err
+	}
+
+	if _, err := dec.Token(); err != nil {
+		return truncated(err)
+	}
+
+	for dec.More() {
+		token, err := dec.Token()
+		if err != nil {
+			return truncated(err)
+		}
+		name, _ := token.(string)
+
+		if decode, ok := elements[name]; ok {
+			if token, err = dec.Token(); err != nil {
+				return truncated(err)
+			} else if token == nil {
+				continue
+			} else if token != json.Delim('[') {
+				return fmt.Errorf("%s must be an array", name)
+			}
+			for dec.More() {
+				if err := decode(dec.Decode); err != nil {
+					return truncated(err)
+				}
+			}
+			if _, err := dec.Token(); err != nil {
+				return truncated(err)
+			}
+			continue
+		}
+
+		value, ok := members[name]
+		if !ok {
+			value = new(json.RawMessage)
+		}
+		if err := dec.Decode(value); err != nil {
+			return truncated(err)
+		}
+	}
+
+	if _, err := dec.Token(); err != nil {
+		return truncated(err)
+	}
+
+	return nil
+}
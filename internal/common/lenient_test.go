@@ -0,0 +1,63 @@
+// Copyright 2022 Sanha Maeng, Soyang Baek, Jinmyeong Kim
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 		http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestDecodeLenient(t *testing.T) {
+	for _, tc := range []struct {
+		body      string
+		items     int
+		failed    bool
+		truncated bool
+	}{
+		{`{"name":"lenient","items":[1,2,3],"skipped":{"a":[1]}}`, 3, false, false},
+		{`{"name":"lenient","items":[1,2,3]`, 3, true, true},
+		{`{"name":"lenient","items":[1,2,`, 2, true, true},
+		{`{"name":"len`, 0, true, true},
+		{`{"name":"lenient","items":[1,2,x]}`, 2, true, false},
+	} {
+		var (
+			name  string
+			items []int
+		)
+		err := DecodeLenient(strings.NewReader(tc.body), map[string]interface{}{"name": &name},
+			map[string]func(func(interface{}) error) error{
+				"items": func(decode func(interface{}) error) error {
+					var item int
+					if err := decode(&item); err != nil {
+						return err
+					}
+					items = append(items, item)
+					return nil
+				},
+			})
+
+		var truncated *TruncatedResponseError
+		if errors.As(err, &truncated) != tc.truncated {
+			t.Errorf("decoding %s: got %v, truncated %v", tc.body, err, tc.truncated)
+		}
+		if (err != nil) != tc.failed {
+			t.Errorf("decoding %s: got %v", tc.body, err)
+		}
+		if len(items) != tc.items {
+			t.Errorf("decoding %s: got %d items, want %d", tc.body, len(items), tc.items)
+		}
+	}
+}
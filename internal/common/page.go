@@ -30,3 +30,36 @@ func RemainingPages(count, size, page, end int) int {
 
 	return min(n, end) - page + 1
 }
+
+// Realign translates @offset, the number of documents already yielded,
+// into the page to request with @size and the number of leading documents of that page to skip.
+func Realign(offset, size int) (page, skip int) { return offset/size + 1, offset % size }
+
+// PageOffset keeps track of the documents an iterator yielded,
+// so that the iterator can resume after them when its page size changes.
+type PageOffset struct {
+	// Yielded is the number of documents yielded until the last request.
+	Yielded int
+	// Size is the page size of the last request, which is zero before the first one.
+	Size int
+}
+
+// Realign returns the page to request with @size instead of @page and the number of its leading documents to skip.
+//
+// @page is kept as is unless the page size changed since the last request.
+func (po PageOffset) Realign(page, size int) (int, int) {
+	if po.Size == 0 || po.Size == size {
+		return page, 0
+	}
+	return Realign(po.Yielded, size)
+}
+
+// Advance records the request of @page with @size.
+func (po *PageOffset) Advance(page, size int) { po.Yielded, po.Size = page*size, size }
+
+// Reset forgets the documents yielded, as the iterator is moved to another page.
+func (po *PageOffset) Reset() { po.Size = 0 }
+
+// Skip returns the index of the first document to yield out of the @n documents of a realigned page,
+// whose leading @skip documents were already yielded.
+func Skip(skip, n int) int { return min(skip, n) }
@@ -26,6 +26,9 @@ import (
 // SaveAsJSON saves @data to @filename.
 //
 // @filename should end with .json.
+//
+// The output is stable: the same @data is always saved as the same bytes,
+// with struct fields in declaration order, map keys sorted and floats in their shortest form.
 func SaveAsJSON(data interface{}, filename string) error {
 	switch tokens := strings.Split(filename, "."); tokens[len(tokens)-1] {
 	case "json":
@@ -50,6 +53,8 @@ func SaveAsJSON(data interface{}, filename string) error {
 // SaveAsJSONorXML saves @data to @filename.
 //
 // @filename should end with .json or .xml.
+//
+// The output is stable in both formats, as in SaveAsJSON.
 func SaveAsJSONorXML(data interface{}, filename string) error {
 	switch tokens := strings.Split(filename, "."); tokens[len(tokens)-1] {
 	case "json":
@@ -0,0 +1,91 @@
+// Copyright 2022 Sanha Maeng, Soyang Baek, Jinmyeong Kim
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 		http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"bytes"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+)
+
+type fixtureDocument struct {
+	Title    string             `json:"title" xml:"title"`
+	Score    float64            `json:"score" xml:"score"`
+	Tags     []string           `json:"tags" xml:"tags"`
+	Extended map[string]float64 `json:"extended" xml:"-"`
+}
+
+type fixtureResult struct {
+	Meta      PageableMeta      `json:"meta" xml:"meta"`
+	Documents []fixtureDocument `json:"documents" xml:"documents"`
+}
+
+// fixture returns a result with a map of many keys, so that an unordered encoding would show up.
+func fixture() fixtureResult {
+	extended := map[string]float64{}
+	for _, key := range []string{"x", "b", "m", "a", "z", "k", "c", "y", "d", "l", "e", "w"} {
+		extended[key] = float64(len(extended)) / 3
+	}
+	return fixtureResult{
+		Meta: PageableMeta{Meta: Meta{TotalCount: 2}, PageableCount: 2, IsEnd: true},
+		Documents: []fixtureDocument{
+			{Title: "<b>히가시노</b> 게이고", Score: 0.1, Tags: []string{"novel"}, Extended: extended},
+			{Title: "& more", Score: 1e21, Extended: map[string]float64{"b": 1, "a": 0.5}},
+		},
+	}
+}
+
+func TestSaveIsStable(t *testing.T) {
+	dir := t.TempDir()
+
+	for _, filename := range []string{"result.json", "result.xml"} {
+		var saved [2][]byte
+		for idx := range saved {
+			path := filepath.Join(dir, filename)
+			if err := SaveAsJSONorXML(fixture(), path); err != nil {
+				t.Fatal(err)
+			}
+			bs, err := ioutil.ReadFile(path)
+			if err != nil {
+				t.Fatal(err)
+			}
+			saved[idx] = bs
+		}
+		if !bytes.Equal(saved[0], saved[1]) {
+			t.Errorf("%s differs between saves:\n%s\n%s", filename, saved[0], saved[1])
+		}
+	}
+
+	if String(fixture()) != String(fixture()) {
+		t.Error("String differs between calls")
+	}
+}
+
+func TestStringSortsMapKeys(t *testing.T) {
+	got := String(fixture().Documents[1])
+	want := `{
+  "title": "& more",
+  "score": 1e+21,
+  "tags": null,
+  "extended": {
+    "a": 0.5,
+    "b": 1
+  }
+}`
+	if got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
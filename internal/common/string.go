@@ -21,6 +21,8 @@ import (
 )
 
 // String implements fmt.Stringer.
+//
+// The output is as stable as the one of SaveAsJSON.
 func String(data interface{}) string {
 	// let's make two buffers
 	// one for encoding, the other for indenting
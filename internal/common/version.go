@@ -0,0 +1,80 @@
+// Copyright 2022 Sanha Maeng, Soyang Baek, Jinmyeong Kim
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 		http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"fmt"
+	"sync"
+)
+
+// apiVersions lists the supported versions of each endpoint, the current one first.
+//
+// Book search v2 responds with a superset of the fields of v3, so both decode into the same result
+// and no decoding shim is needed.
+//
+// Left out on purpose:
+//   - the v1 Daum searches, which were served by the retired apis.daum.net with another response shape,
+//   - the Local, Translation, Vision and Pose endpoints, which have a single version each,
+//   - the user endpoints such as user/me, which this client does not provide.
+var apiVersions = map[string][]string{
+	"daum.web":   {"v2"},
+	"daum.vclip": {"v2"},
+	"daum.image": {"v2"},
+	"daum.blog":  {"v2"},
+	"daum.book":  {"v3", "v2"},
+	"daum.cafe":  {"v2"},
+}
+
+var (
+	pinnedMu sync.RWMutex
+	pinned   = map[string]string{}
+)
+
+// SetAPIVersion pins @endpoint, such as daum.book, to @version, such as v3.
+//
+// It fails with ErrUnsupportedAPIVersion if @endpoint does not support @version.
+func SetAPIVersion(endpoint, version string) error {
+	for _, supported := range apiVersions[endpoint] {
+		if supported == version {
+			pinnedMu.Lock()
+			defer pinnedMu.Unlock()
+
+			pinned[endpoint] = version
+			return nil
+		}
+	}
+	return fmt.Errorf("%w: %s of %s", ErrUnsupportedAPIVersion, version, endpoint)
+}
+
+// APIVersion returns the version @endpoint is pinned to, or its current version.
+//
+// It returns an empty string if @endpoint has no versions registered.
+func APIVersion(endpoint string) string {
+	pinnedMu.RLock()
+	defer pinnedMu.RUnlock()
+
+	if version, ok := pinned[endpoint]; ok {
+		return version
+	}
+	if versions := apiVersions[endpoint]; 0 < len(versions) {
+		return versions[0]
+	}
+	return ""
+}
+
+// APIVersions returns the supported versions of @endpoint, the current one first.
+func APIVersions(endpoint string) []string {
+	return append([]string(nil), apiVersions[endpoint]...)
+}
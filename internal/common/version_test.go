@@ -0,0 +1,60 @@
+// Copyright 2022 Sanha Maeng, Soyang Baek, Jinmyeong Kim
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 		http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestSetAPIVersion(t *testing.T) {
+	t.Cleanup(func() { SetAPIVersion("daum.book", "v3") })
+
+	if got := APIVersion("daum.book"); got != "v3" {
+		t.Errorf("got %s, want the current version v3", got)
+	}
+
+	if err := SetAPIVersion("daum.book", "v2"); err != nil {
+		t.Fatal(err)
+	}
+	if got := APIVersion("daum.book"); got != "v2" {
+		t.Errorf("got %s, want the pinned version v2", got)
+	}
+
+	for _, tc := range []struct{ endpoint, version string }{
+		{"daum.book", "v1"},
+		{"daum.web", "v3"},
+		{"user.me", "v2"},
+	} {
+		if err := SetAPIVersion(tc.endpoint, tc.version); !errors.Is(err, ErrUnsupportedAPIVersion) {
+			t.Errorf("got %v for %s of %s, want %v", err, tc.version, tc.endpoint, ErrUnsupportedAPIVersion)
+		}
+	}
+	if got := APIVersion("daum.book"); got != "v2" {
+		t.Errorf("got %s, want the version kept at v2", got)
+	}
+}
+
+func TestAPIVersionUnknownEndpoint(t *testing.T) {
+	if got := APIVersion("user.me"); got != "" {
+		t.Errorf("got %q, want no version", got)
+	}
+	if got := APIVersions("user.me"); len(got) != 0 {
+		t.Errorf("got %v, want no versions", got)
+	}
+	if got := APIVersions("daum.book"); len(got) != 2 || got[0] != "v3" {
+		t.Errorf("got %v, want v3 first", got)
+	}
+}
@@ -0,0 +1,114 @@
+// Copyright 2022 Sanha Maeng, Soyang Baek, Jinmyeong Kim
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 		http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package stability_test checks that the outputs covered by the stability guarantee of the writers
+// are byte-stable for the result types of every package.
+package stability_test
+
+import (
+	"bytes"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/maengsanha/kakao-developers-client/channel"
+	"github.com/maengsanha/kakao-developers-client/daum"
+	"github.com/maengsanha/kakao-developers-client/local"
+	"github.com/maengsanha/kakao-developers-client/vision"
+)
+
+func books() daum.BookSearchResults {
+	var res daum.BookSearchResult
+	res.Meta.TotalCount, res.Meta.PageableCount = 2, 2
+	res.Documents = []daum.BookResult{
+		{
+			WebResult: daum.WebResult{Title: "용의자 X의 헌신", Contents: "<b>천재</b> & 수학자", Datetime: "2006-08-01T00:00:00.000+09:00"},
+			ISBN:      "8990982618 9788990982612", Authors: []string{"히가시노 게이고"}, Price: 9800, SalePrice: 8820,
+		},
+		{WebResult: daum.WebResult{Title: "The Devotion of Suspect X"}, ISBN: "0312375069 9780312375065"},
+	}
+	return daum.BookSearchResults{res}
+}
+
+func addresses() local.AddressSearchResults {
+	var res local.AddressSearchResult
+	res.Meta.TotalCount = 1
+	res.Documents = []local.ComplexAddress{{AddressName: "서울 중구 을지로 1", X: "126.978", Y: "37.566"}}
+	return local.AddressSearchResults{res}
+}
+
+func sweep() vision.SweepReport {
+	return vision.SweepReport{Filename: "face.png", Points: []vision.SweepPoint{
+		{Threshold: 0.1, Faces: 3, MeanScore: 0.1 + 0.2},
+		{Threshold: 0.7, Faces: 1, MeanScore: 1e-7, DeltaFaces: -2, DeltaMeanScore: 1e-7 - 0.3},
+	}}
+}
+
+func relations() channel.RelationsResult {
+	return channel.RelationsResult{UserID: 1234, Channels: []channel.ChannelRelation{
+		{UUID: "@added", PublicID: "_added", Relation: channel.RelationAdded},
+		{UUID: "@blocked", PublicID: "_blocked", Relation: channel.RelationBlocked},
+	}}
+}
+
+func TestWritersAreStable(t *testing.T) {
+	at := time.Date(2022, 1, 1, 0, 0, 0, 0, time.FixedZone("KST", 9*60*60))
+
+	for _, tc := range []struct {
+		filename string
+		save     func(filename string) error
+	}{
+		{"books.json", func(filename string) error { return books().SaveAs(filename) }},
+		{"addresses.json", func(filename string) error { return addresses().SaveAs(filename) }},
+		{"addresses.xml", func(filename string) error { return addresses().SaveAs(filename) }},
+		{"sweep.json", func(filename string) error { return sweep().SaveAs(filename) }},
+		{"sweep.csv", func(filename string) error { return sweep().SaveAsCSV(filename) }},
+		{"relations.xml", func(filename string) error { return relations().SaveAs(filename) }},
+		{"prices.jsonl", func(filename string) error {
+			history, err := daum.OpenPriceHistory(filename)
+			if err != nil {
+				return err
+			}
+			return history.Record(books(), at)
+		}},
+	} {
+		var saved [2][]byte
+		for idx := range saved {
+			filename := filepath.Join(t.TempDir(), tc.filename)
+			if err := tc.save(filename); err != nil {
+				t.Fatalf("%s: %v", tc.filename, err)
+			}
+			bs, err := ioutil.ReadFile(filename)
+			if err != nil {
+				t.Fatal(err)
+			}
+			saved[idx] = bs
+		}
+		if len(saved[0]) == 0 || !bytes.Equal(saved[0], saved[1]) {
+			t.Errorf("%s differs between saves:\n%s\n%s", tc.filename, saved[0], saved[1])
+		}
+	}
+
+	for name, stringer := range map[string]func() string{
+		"books":     func() string { return books()[0].String() },
+		"addresses": func() string { return addresses()[0].String() },
+		"sweep":     func() string { return sweep().String() },
+		"relations": func() string { return relations().String() },
+	} {
+		if stringer() != stringer() {
+			t.Errorf("String of %s differs between calls", name)
+		}
+	}
+}
@@ -93,6 +93,7 @@ type AddressSearchIterator struct {
 	Page        int
 	Size        int
 	end         bool
+	offset      common.PageOffset
 }
 
 // AddressSearch provides the coordinates of the requested address with @query.
@@ -148,6 +149,7 @@ func (it *AddressSearchIterator) Analyze(typ string) *AddressSearchIterator {
 func (it *AddressSearchIterator) Result(page int) *AddressSearchIterator {
 	if 1 <= page && page <= 45 {
 		it.Page = page
+		it.offset.Reset()
 	} else {
 		panic(common.ErrPageOutOfBound)
 	}
@@ -172,13 +174,20 @@ func (it *AddressSearchIterator) Display(size int) *AddressSearchIterator {
 
 // Next returns the address search result and proceeds the iterator to the next page.
 func (it *AddressSearchIterator) Next() (res AddressSearchResult, err error) {
+	defer common.WrapError(OpAddressSearch, &err)
+
 	// if there is no more result, return error
 	if it.end {
 		return res, Done
 	}
 
+	var skip int
+	if it.Page, skip = it.offset.Realign(it.Page, it.Size); 45 < it.Page {
+		it.end = true
+		return res, Done
+	}
+
 	// at first, send request to the API server
-	client := &http.Client{}
 	req, err := http.NewRequest(http.MethodGet,
 		fmt.Sprintf("%ssearch/address.%s?query=%s&analyze_type=%s&page=%d&size=%d",
 			prefix, it.Format, it.Query, it.AnalyzeType, it.Page, it.Size), nil)
@@ -192,7 +201,7 @@ func (it *AddressSearchIterator) Next() (res AddressSearchResult, err error) {
 	// set authorization header
 	req.Header.Set(common.Authorization, it.AuthKey)
 
-	resp, err := client.Do(req)
+	resp, err := common.Do(Service, req)
 	if err != nil {
 		return
 	}
@@ -210,6 +219,9 @@ func (it *AddressSearchIterator) Next() (res AddressSearchResult, err error) {
 		}
 	}
 
+	res.Documents = res.Documents[common.Skip(skip, len(res.Documents)):]
+	it.offset.Advance(it.Page, it.Size)
+
 	it.end = res.Meta.IsEnd || 45 < it.Page
 
 	it.Page++
@@ -15,9 +15,13 @@
 package local_test
 
 import (
+	"errors"
 	"internal/common"
+	"net/http"
+	"strings"
 	"testing"
 
+	"github.com/maengsanha/kakao-developers-client/internal/mock"
 	"github.com/maengsanha/kakao-developers-client/local"
 )
 
@@ -136,3 +140,18 @@ func TestAddressSearchCollectAll(t *testing.T) {
 		t.Log(item)
 	}
 }
+
+func TestAddressSearchErrorWrapping(t *testing.T) {
+	mock.Kakao(t, func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("sent a request with %s", r.Header.Get(common.Authorization))
+	})
+
+	// an access token given in place of a REST API key
+	_, err := local.AddressSearch("을지로").
+		AuthorizeWith("Xq3vYb_0aFzE-9kTn2LdPw7RsUc4HmJgKe1BoAi6Nt8Vy5WxZl").
+		Next()
+
+	if !errors.Is(err, local.ErrCredentialKindMismatch) || !strings.HasPrefix(err.Error(), local.OpAddressSearch+": ") {
+		t.Errorf("got %v, want %v prefixed with %s", err, local.ErrCredentialKindMismatch, local.OpAddressSearch)
+	}
+}
@@ -41,6 +41,7 @@ type CategorySearchIterator struct {
 	Size              int
 	Sort              string
 	end               bool
+	offset            common.PageOffset
 }
 
 // PlaceSearchByCategory provides the search results for place by group code in the specified order.
@@ -169,6 +170,7 @@ func (it *CategorySearchIterator) WithRect(xMin, yMin, xMax, yMax float64) *Cate
 func (it *CategorySearchIterator) Result(page int) *CategorySearchIterator {
 	if 1 <= page && page <= 45 {
 		it.Page = page
+		it.offset.Reset()
 	} else {
 		panic(common.ErrPageOutOfBound)
 	}
@@ -209,11 +211,18 @@ func (it *CategorySearchIterator) SortBy(order string) *CategorySearchIterator {
 
 // Next returns the place search result.
 func (it *CategorySearchIterator) Next() (res PlaceSearchResult, err error) {
+	defer common.WrapError(OpCategorySearch, &err)
+
 	if it.end {
 		return res, Done
 	}
 
-	client := &http.Client{}
+	var skip int
+	if it.Page, skip = it.offset.Realign(it.Page, it.Size); 45 < it.Page {
+		it.end = true
+		return res, Done
+	}
+
 	req, err := http.NewRequest(http.MethodGet,
 		fmt.Sprintf("%ssearch/category.%s?category_group_code=%s&page=%d&size=%d&sort=%s&x=%s&y=%s&radius=%d&rect=%s",
 			prefix, it.Format, it.CategoryGroupCode, it.Page, it.Size, it.Sort, it.X, it.Y, it.Radius, it.Rect), nil)
@@ -226,7 +235,7 @@ func (it *CategorySearchIterator) Next() (res PlaceSearchResult, err error) {
 
 	req.Header.Set(common.Authorization, it.AuthKey)
 
-	resp, err := client.Do(req)
+	resp, err := common.Do(Service, req)
 	if err != nil {
 		return
 	}
@@ -243,6 +252,9 @@ func (it *CategorySearchIterator) Next() (res PlaceSearchResult, err error) {
 		}
 	}
 
+	res.Documents = res.Documents[common.Skip(skip, len(res.Documents)):]
+	it.offset.Advance(it.Page, it.Size)
+
 	it.end = res.Meta.IsEnd || 45 < it.Page
 
 	it.Page++
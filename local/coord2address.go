@@ -142,7 +142,8 @@ func (ci *CoordToAddressInitializer) Input(coord string) *CoordToAddressInitiali
 
 // Collect returns the land-lot number address(with post number) and road name address.
 func (ci *CoordToAddressInitializer) Collect() (res CoordToAddressResult, err error) {
-	client := &http.Client{}
+	defer common.WrapError(OpCoordToAddress, &err)
+
 	req, err := http.NewRequest(http.MethodGet,
 		fmt.Sprintf("%sgeo/coord2address.%s?x=%s&y=%s&input_coord=%s",
 			prefix, ci.Format, ci.X, ci.Y, ci.InputCoord), nil)
@@ -155,7 +156,7 @@ func (ci *CoordToAddressInitializer) Collect() (res CoordToAddressResult, err er
 
 	req.Header.Set(common.Authorization, ci.AuthKey)
 
-	resp, err := client.Do(req)
+	resp, err := common.Do(Service, req)
 	if err != nil {
 		return
 	}
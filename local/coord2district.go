@@ -159,7 +159,8 @@ func (ci *CoordToDistrictInitializer) Output(coord string) *CoordToDistrictIniti
 
 // Collect returns the coordinate conversion result.
 func (ci *CoordToDistrictInitializer) Collect() (res CoordToDistrictResult, err error) {
-	client := &http.Client{}
+	defer common.WrapError(OpCoordToDistrict, &err)
+
 	req, err := http.NewRequest(http.MethodGet,
 		fmt.Sprintf("%sgeo/coord2regioncode.%s?x=%s&y=%s&input_coord=%s&output_coord=%s",
 			prefix, ci.Format, ci.X, ci.Y, ci.InputCoord, ci.OutputCoord), nil)
@@ -172,7 +173,7 @@ func (ci *CoordToDistrictInitializer) Collect() (res CoordToDistrictResult, err
 
 	req.Header.Set(common.Authorization, ci.AuthKey)
 
-	resp, err := client.Do(req)
+	resp, err := common.Do(Service, req)
 	if err != nil {
 		return
 	}
@@ -21,8 +21,11 @@ import (
 
 var (
 	Done                            = common.ErrEndPage
+	ErrCredentialKindMismatch       = common.ErrCredentialKindMismatch
+	ErrCircuitOpen                  = common.ErrCircuitOpen
 	ErrUnsupportedCategoryGroupCode = errors.New(
 		`category group code must be one of the following options:
 		MT1, CS2, PS3, SC4, AC5, PK6, OL7, SW8, CT1, AG2, PO3, AT4, FD6, CE7, HP8, PM9, BK9, AD5`)
 	ErrRadiusOutOfBound = errors.New("radius must be between 0 and 20000")
+	ErrAddressNotFound  = errors.New("no address matches the query")
 )
@@ -42,6 +42,7 @@ type KeywordSearchIterator struct {
 	Size              int
 	Sort              string
 	end               bool
+	offset            common.PageOffset
 }
 
 // PlaceSearchByKeyword provides the search results for places that match @query
@@ -173,6 +174,7 @@ func (it *KeywordSearchIterator) WithRect(xMin, yMin, xMax, yMax float64) *Keywo
 func (it *KeywordSearchIterator) Result(page int) *KeywordSearchIterator {
 	if 1 <= page && page <= 45 {
 		it.Page = page
+		it.offset.Reset()
 	} else {
 		panic(common.ErrPageOutOfBound)
 	}
@@ -215,11 +217,17 @@ func (it *KeywordSearchIterator) SortBy(order string) *KeywordSearchIterator {
 
 // Next returns the place search result and proceeds the iterator to the next page.
 func (it *KeywordSearchIterator) Next() (res PlaceSearchResult, err error) {
+	defer common.WrapError(OpKeywordSearch, &err)
+
 	if it.end {
 		return res, Done
 	}
 
-	client := &http.Client{}
+	var skip int
+	if it.Page, skip = it.offset.Realign(it.Page, it.Size); 45 < it.Page {
+		it.end = true
+		return res, Done
+	}
 
 	req, err := http.NewRequest(http.MethodGet,
 		fmt.Sprintf("%ssearch/keyword.%s?query=%s&category_group_code=%s&x=%s&y=%s&radius=%d&rect=%s&page=%d&size=%d&sort=%s",
@@ -233,7 +241,7 @@ func (it *KeywordSearchIterator) Next() (res PlaceSearchResult, err error) {
 
 	req.Header.Set(common.Authorization, it.AuthKey)
 
-	resp, err := client.Do(req)
+	resp, err := common.Do(Service, req)
 	if err != nil {
 		return
 	}
@@ -250,6 +258,9 @@ func (it *KeywordSearchIterator) Next() (res PlaceSearchResult, err error) {
 		}
 	}
 
+	res.Documents = res.Documents[common.Skip(skip, len(res.Documents)):]
+	it.offset.Advance(it.Page, it.Size)
+
 	it.end = res.Meta.IsEnd || 45 < it.Page
 
 	it.Page++
@@ -15,9 +15,12 @@
 package local_test
 
 import (
+	"fmt"
 	"internal/common"
+	"net/http"
 	"testing"
 
+	"github.com/maengsanha/kakao-developers-client/internal/mock"
 	"github.com/maengsanha/kakao-developers-client/local"
 )
 
@@ -186,3 +189,45 @@ func TestKeywordSearchCollectAll(t *testing.T) {
 		t.Log(item)
 	}
 }
+
+func TestKeywordSearchDisplayChangedMidIteration(t *testing.T) {
+	const total = 100
+
+	mock.Kakao(t, func(w http.ResponseWriter, r *http.Request) {
+		page, size := mock.Page(r)
+		res := local.PlaceSearchResult{}
+		for idx := (page - 1) * size; idx < page*size && idx < total; idx++ {
+			res.Documents = append(res.Documents, local.Place{Id: fmt.Sprint(idx)})
+		}
+		res.Meta.IsEnd = total <= page*size
+		fmt.Fprint(w, res)
+	})
+
+	it := local.PlaceSearchByKeyword("카카오").Display(15)
+
+	var ids []string
+	for {
+		if len(ids) == 30 {
+			it.Display(45)
+		}
+		item, err := it.Next()
+		if err == local.Done {
+			break
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, doc := range item.Documents {
+			ids = append(ids, doc.Id)
+		}
+	}
+
+	if len(ids) != total {
+		t.Fatalf("got %d documents, want %d", len(ids), total)
+	}
+	for idx, id := range ids {
+		if id != fmt.Sprint(idx) {
+			t.Fatalf("document %d is %s, want %d", idx, id, idx)
+		}
+	}
+}
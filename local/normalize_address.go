@@ -0,0 +1,123 @@
+// Copyright 2022 Sanha Maeng, Soyang Baek, Jinmyeong Kim
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 		http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package local
+
+import (
+	"regexp"
+	"strings"
+)
+
+// Variants of an address NormalizeAndGeocode can match with.
+const (
+	VariantExact      = "exact"
+	VariantSimilar    = "similar"
+	VariantNormalized = "normalized"
+)
+
+// regionNames maps the abbreviated names of provinces and metropolitan cities to their full names.
+//
+// 광주시 is left out, as it is the official name of a city of 경기도 rather than an abbreviation of 광주광역시.
+var regionNames = map[string]string{
+	"서울": "서울특별시", "서울시": "서울특별시",
+	"부산": "부산광역시", "부산시": "부산광역시",
+	"대구": "대구광역시", "대구시": "대구광역시",
+	"인천": "인천광역시", "인천시": "인천광역시",
+	"광주": "광주광역시",
+	"대전": "대전광역시", "대전시": "대전광역시",
+	"울산": "울산광역시", "울산시": "울산광역시",
+	"세종": "세종특별자치시", "세종시": "세종특별자치시",
+	"경기": "경기도",
+	"강원": "강원특별자치도", "강원도": "강원특별자치도",
+	"충북": "충청북도",
+	"충남": "충청남도",
+	"전북": "전북특별자치도", "전라북도": "전북특별자치도",
+	"전남": "전라남도",
+	"경북": "경상북도",
+	"경남": "경상남도",
+	"제주": "제주특별자치도", "제주도": "제주특별자치도",
+}
+
+var (
+	// numberedToken matches a road (로, 길) or a neighborhood (동, 리, 가) glued to its number.
+	numberedToken = regexp.MustCompile(`^(\p{Hangul}[\p{Hangul}\d]*(?:로|길|동|리|가))(산?\d+(?:-\d+)?)$`)
+	// detailToken matches a unit, floor or building block which follows the address.
+	detailToken = regexp.MustCompile(`^(?:지하|B|b)?\d+(?:-\d+)?(?:층|호|동)$|^\(`)
+)
+
+// SplitAddress cleans up @s for geocoding and splits it into the address and the detail,
+// such as units and floors, which geocoding does not need.
+//
+// The cleanup is deterministic: whitespaces are collapsed, abbreviated province and city names are
+// spelled out, and numbers glued to road or neighborhood names are separated from them.
+func SplitAddress(s string) (address, detail string) {
+	var details []string
+	if idx := strings.IndexAny(s, ",，"); 0 <= idx {
+		s, details = s[:idx], append(details, strings.Trim(s[idx:], ",， "))
+	}
+
+	fields := strings.Fields(s)
+
+	var tokens []string
+	for idx, token := range fields {
+		if idx == 0 {
+			if name, ok := regionNames[token]; ok {
+				token = name
+			}
+		}
+		if 0 < idx && detailToken.MatchString(token) {
+			details = append(append([]string{}, fields[idx:]...), details...)
+			break
+		}
+		if match := numberedToken.FindStringSubmatch(token); match != nil {
+			tokens = append(tokens, match[1], match[2])
+		} else {
+			tokens = append(tokens, token)
+		}
+	}
+
+	return strings.Join(tokens, " "), strings.TrimSpace(strings.Join(details, " "))
+}
+
+// NormalizeAddress cleans up @s for geocoding, dropping the units and floors.
+//
+// See SplitAddress for the rules.
+func NormalizeAddress(s string) string {
+	address, _ := SplitAddress(s)
+	return address
+}
+
+// NormalizeAndGeocode geocodes the address @s, trying the exact analysis, then the similar analysis,
+// and then the similar analysis of the normalized address.
+//
+// It returns the first result with documents, along with the variant which matched.
+func NormalizeAndGeocode(s, authKey string) (res AddressSearchResult, variant string, err error) {
+	for _, analyze := range []string{VariantExact, VariantSimilar} {
+		if res, err = AddressSearch(s).AuthorizeWith(authKey).Analyze(analyze).Next(); err != nil {
+			return res, "", err
+		} else if 0 < len(res.Documents) {
+			return res, analyze, nil
+		}
+	}
+
+	if normalized := NormalizeAddress(s); normalized != "" && normalized != strings.TrimSpace(s) {
+		if res, err = AddressSearch(normalized).AuthorizeWith(authKey).Analyze("similar").Next(); err != nil {
+			return res, "", err
+		} else if 0 < len(res.Documents) {
+			return res, VariantNormalized, nil
+		}
+	}
+
+	return res, "", ErrAddressNotFound
+}
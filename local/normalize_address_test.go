@@ -0,0 +1,89 @@
+// Copyright 2022 Sanha Maeng, Soyang Baek, Jinmyeong Kim
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 		http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package local_test
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/maengsanha/kakao-developers-client/internal/mock"
+	"github.com/maengsanha/kakao-developers-client/local"
+)
+
+func TestSplitAddress(t *testing.T) {
+	for _, tc := range []struct {
+		input, address, detail string
+	}{
+		{"", "", ""},
+		{"서울 강남구 테헤란로212", "서울특별시 강남구 테헤란로 212", ""},
+		{"  서울   강남구  테헤란로 212  ", "서울특별시 강남구 테헤란로 212", ""},
+		{"서울시 강남구 테헤란로212 5층", "서울특별시 강남구 테헤란로 212", "5층"},
+		{"서울 강남구 강남대로94길10, 3층 301호", "서울특별시 강남구 강남대로94길 10", "3층 301호"},
+		{"서울특별시 종로구 종로3가", "서울특별시 종로구 종로3가", ""},
+		{"부산 해운대구 우동1408", "부산광역시 해운대구 우동 1408", ""},
+		{"대전 유성구 대학로291 B1층", "대전광역시 유성구 대학로 291", "B1층"},
+		{"경기 용인시 수지구 풍덕천동 123-4 101동 1001호", "경기도 용인시 수지구 풍덕천동 123-4", "101동 1001호"},
+		{"강원도 춘천시 중앙로1", "강원특별자치도 춘천시 중앙로 1", ""},
+		{"제주 제주시 첨단로242 (영평동)", "제주특별자치도 제주시 첨단로 242", "(영평동)"},
+		{"전남 나주시 빛가람로 지하1층", "전라남도 나주시 빛가람로", "지하1층"},
+		{"광주 북구 용봉로77", "광주광역시 북구 용봉로 77", ""},
+		{"광주시 오포읍 오포로 10", "광주시 오포읍 오포로 10", ""},
+		{"경기 광주시 오포읍 오포로 10", "경기도 광주시 오포읍 오포로 10", ""},
+	} {
+		address, detail := local.SplitAddress(tc.input)
+		if address != tc.address || detail != tc.detail {
+			t.Errorf("SplitAddress(%q) = (%q, %q), want (%q, %q)", tc.input, address, detail, tc.address, tc.detail)
+		}
+		if normalized := local.NormalizeAddress(tc.input); normalized != tc.address {
+			t.Errorf("NormalizeAddress(%q) = %q, want %q", tc.input, normalized, tc.address)
+		}
+	}
+}
+
+func TestNormalizeAndGeocode(t *testing.T) {
+	// the mocked server only knows well-formed queries
+	known := map[string]map[string]bool{
+		"exact":   {"서울특별시 강남구 테헤란로 212": true},
+		"similar": {"서울특별시 강남구 테헤란로 212": true, "서울 강남구 테헤란로 212": true},
+	}
+
+	mock.Kakao(t, func(w http.ResponseWriter, r *http.Request) {
+		res := local.AddressSearchResult{}
+		query := r.URL.Query().Get("query")
+		if known[r.URL.Query().Get("analyze_type")][query] {
+			res.Documents = append(res.Documents, local.ComplexAddress{AddressName: query})
+		}
+		fmt.Fprint(w, res)
+	})
+
+	for _, tc := range []struct {
+		input, variant string
+		err            error
+	}{
+		{"서울특별시 강남구 테헤란로 212", local.VariantExact, nil},
+		{"서울 강남구 테헤란로 212", local.VariantSimilar, nil},
+		{"서울  강남구 테헤란로212 5층", local.VariantNormalized, nil},
+		{"부산 해운대구 우동1408", "", local.ErrAddressNotFound},
+	} {
+		res, variant, err := local.NormalizeAndGeocode(tc.input, "")
+		if variant != tc.variant || err != tc.err {
+			t.Errorf("NormalizeAndGeocode(%q) matched %q with %v, want %q with %v", tc.input, variant, err, tc.variant, tc.err)
+		}
+		if err == nil && len(res.Documents) == 0 {
+			t.Errorf("NormalizeAndGeocode(%q) returned no documents", tc.input)
+		}
+	}
+}
@@ -172,8 +172,9 @@ func (ti *TransCoordInitializer) Output(coord string) *TransCoordInitializer {
 
 // Collect returns the coordinate system conversion result.
 func (ti *TransCoordInitializer) Collect() (res TransCoordResult, err error) {
+	defer common.WrapError(OpTransCoord, &err)
+
 	// at first, send request to the API server
-	client := &http.Client{}
 	req, err := http.NewRequest(http.MethodGet,
 		fmt.Sprintf("https://dapi.kakao.com/v2/local/geo/transcoord.%s?x=%s&y=%s&input_coord=%s&output_coord=%s",
 			ti.Format, ti.X, ti.Y, ti.InputCoord, ti.OutputCoord), nil)
@@ -187,7 +188,7 @@ func (ti *TransCoordInitializer) Collect() (res TransCoordResult, err error) {
 	// set authorization header
 	req.Header.Set(common.Authorization, ti.AuthKey)
 
-	resp, err := client.Do(req)
+	resp, err := common.Do(Service, req)
 	if err != nil {
 		return
 	}
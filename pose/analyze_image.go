@@ -85,6 +85,8 @@ func (ai *AnalyzeImageInitializer) AuthorizeWith(key string) *AnalyzeImageInitia
 
 // Collect returns the image analyze result.
 func (ai *AnalyzeImageInitializer) Collect() (res AnalyzeImageResult, err error) {
+	defer common.WrapError(OpAnalyzeImage, &err)
+
 	var req *http.Request
 	if ai.withFile {
 		file, err := os.Open(ai.Filename)
@@ -131,8 +133,7 @@ func (ai *AnalyzeImageInitializer) Collect() (res AnalyzeImageResult, err error)
 	req.Close = true
 	req.Header.Add(common.Authorization, ai.AuthKey)
 
-	client := &http.Client{}
-	resp, err := client.Do(req)
+	resp, err := common.Do(Service, req)
 	if err != nil {
 		return
 	}
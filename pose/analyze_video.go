@@ -95,6 +95,8 @@ func (ai *AnalyzeVideoInitializer) ReceiveTo(url string) *AnalyzeVideoInitialize
 
 // Collect returns the result of AnalyzeVideo.
 func (ai *AnalyzeVideoInitializer) Collect() (res AnalyzeVideoResult, err error) {
+	defer common.WrapError(OpAnalyzeVideo, &err)
+
 	var req *http.Request
 	if ai.withFile {
 		file, err := os.Open(ai.Filename)
@@ -142,8 +144,7 @@ func (ai *AnalyzeVideoInitializer) Collect() (res AnalyzeVideoResult, err error)
 	req.Close = true
 	req.Header.Add(common.Authorization, ai.AuthKey)
 
-	client := &http.Client{}
-	resp, err := client.Do(req)
+	resp, err := common.Do(Service, req)
 	if err != nil {
 		return
 	}
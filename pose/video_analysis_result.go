@@ -89,6 +89,7 @@ type Video struct {
 type CheckVideoInitializer struct {
 	AuthKey string
 	JobId   string
+	lenient bool
 }
 
 // CheckVideo returns the processing status and the video analysis results processed through the analyze_video API.
@@ -107,9 +108,16 @@ func (ci *CheckVideoInitializer) AuthorizeWith(key string) *CheckVideoInitialize
 	return ci
 }
 
+// Lenient makes Collect keep the annotations decoded before the response is truncated,
+// returning them along with a *TruncatedResponseError.
+func (ci *CheckVideoInitializer) Lenient() *CheckVideoInitializer {
+	ci.lenient = true
+	return ci
+}
+
 // Collect returns the check video result.
 func (ci *CheckVideoInitializer) Collect() (res CheckVideoResult, err error) {
-	client := &http.Client{}
+	defer common.WrapError(OpCheckVideo, &err)
 
 	req, err := http.NewRequest(http.MethodGet, fmt.Sprintf("%s/job/%s", prefix, ci.JobId), nil)
 	if err != nil {
@@ -119,13 +127,34 @@ func (ci *CheckVideoInitializer) Collect() (res CheckVideoResult, err error) {
 	req.Close = true
 	req.Header.Set(common.Authorization, ci.AuthKey)
 
-	resp, err := client.Do(req)
+	resp, err := common.Do(Service, req)
 	if err != nil {
 		return
 	}
 
 	defer resp.Body.Close()
 
+	if ci.lenient {
+		err = common.DecodeLenient(resp.Body, map[string]interface{}{
+			"job_id":      &res.JobId,
+			"status":      &res.Status,
+			"categories":  &res.Categories,
+			"info":        &res.Info,
+			"video":       &res.Video,
+			"description": &res.Description,
+		}, map[string]func(func(interface{}) error) error{
+			"annotations": func(decode func(interface{}) error) error {
+				var annotation Annotation
+				if err := decode(&annotation); err != nil {
+					return err
+				}
+				res.Annotations = append(res.Annotations, annotation)
+				return nil
+			},
+		})
+		return
+	}
+
 	if err = json.NewDecoder(resp.Body).Decode(&res); err != nil {
 		return
 	}
@@ -15,9 +15,15 @@
 package pose_test
 
 import (
+	"errors"
+	"fmt"
 	"internal/common"
+	"net/http"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/maengsanha/kakao-developers-client/internal/mock"
 	"github.com/maengsanha/kakao-developers-client/pose"
 )
 
@@ -44,3 +50,80 @@ func TestVideoAnalyzeResultSaveAsJSON(t *testing.T) {
 		t.Error(cr)
 	}
 }
+
+// truncatedVideoResult returns a video analysis result with @n annotations,
+// along with the offsets where each annotation ends.
+func truncatedVideoResult(n int) (body string, ends []int) {
+	var sb strings.Builder
+	sb.WriteString(`{"job_id":"9524567f-887b-474f-9e33-a3d480b400c1","status":"success","annotations":[`)
+	for idx := 0; idx < n; idx++ {
+		if 0 < idx {
+			sb.WriteString(",")
+		}
+		fmt.Fprintf(&sb, `{"frame_num":%d,"objects":[{"area":1024.5,"bbox":[1,2,3,4],"category_id":1,"keypoints":[1,2,3],"score":0.9}]}`, idx)
+		ends = append(ends, sb.Len())
+	}
+	sb.WriteString(`],"video":{"fps":30,"frames":10,"height":720,"width":1280}}`)
+	return sb.String(), ends
+}
+
+func TestVideoAnalyzeResultLenient(t *testing.T) {
+	body, ends := truncatedVideoResult(10)
+
+	cut := len(body)
+	mock.Kakao(t, func(w http.ResponseWriter, r *http.Request) { fmt.Fprint(w, body[:cut]) })
+
+	if cr, err := pose.CheckVideo("9524567f-887b-474f-9e33-a3d480b400c1").Lenient().Collect(); err != nil {
+		t.Fatal(err)
+	} else if len(cr.Annotations) != 10 || cr.Video.Frames != 10 || cr.Status != "success" {
+		t.Errorf("unexpected result of an untruncated response %+v", cr)
+	}
+
+	for _, tc := range []struct {
+		cut, salvaged int
+	}{
+		{40, 0},
+		{ends[0] - 1, 0},
+		{ends[0], 1},
+		{ends[3] + 5, 4},
+		{ends[9], 10},
+		{len(body) - 1, 10},
+	} {
+		cut = tc.cut
+
+		cr, err := pose.CheckVideo("9524567f-887b-474f-9e33-a3d480b400c1").Lenient().Collect()
+
+		var truncated *pose.TruncatedResponseError
+		if !errors.As(err, &truncated) || !errors.Is(err, pose.ErrTruncatedResponse) {
+			t.Errorf("cut at %d: got %v, want a truncated response error", cut, err)
+		} else if truncated.Offset < 0 || int64(cut) < truncated.Offset {
+			t.Errorf("cut at %d: truncated at offset %d", cut, truncated.Offset)
+		}
+		if len(cr.Annotations) != tc.salvaged {
+			t.Errorf("cut at %d: salvaged %d annotations, want %d", cut, len(cr.Annotations), tc.salvaged)
+		}
+
+		// strict decoding salvages nothing
+		if _, err := pose.CheckVideo("9524567f-887b-474f-9e33-a3d480b400c1").Collect(); err == nil || errors.Is(err, pose.ErrTruncatedResponse) {
+			t.Errorf("cut at %d: strict decoding returned %v", cut, err)
+		}
+	}
+}
+
+func TestCheckVideoErrorWrapping(t *testing.T) {
+	mock.Kakao(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+		fmt.Fprint(w, `{}`)
+	})
+
+	common.SetCircuitBreaker(pose.Service, common.CircuitBreakerConfig{Failures: 1, CoolDown: time.Hour})
+	t.Cleanup(func() { common.SetCircuitBreaker(pose.Service, common.CircuitBreakerConfig{}) })
+
+	// the failing service opens the circuit
+	pose.CheckVideo("9524567f-887b-474f-9e33-a3d480b400c1").Collect()
+
+	_, err := pose.CheckVideo("9524567f-887b-474f-9e33-a3d480b400c1").Collect()
+	if !errors.Is(err, pose.ErrCircuitOpen) || !strings.HasPrefix(err.Error(), pose.OpCheckVideo+": ") {
+		t.Errorf("got %v, want %v prefixed with %s", err, pose.ErrCircuitOpen, pose.OpCheckVideo)
+	}
+}
@@ -15,6 +15,8 @@
 package translation
 
 import (
+	"bytes"
+	"context"
 	"errors"
 	"fmt"
 	"internal/common"
@@ -38,6 +40,53 @@ type DetectResult struct {
 	LanguageInfo []LanguageInfo `json:"language_info"`
 }
 
+// UnmarshalJSON implements json.Unmarshaler.
+//
+// The candidates are accepted under either language_info or languageInfo,
+// as an array or as a single object, in the order of the response.
+func (dr *DetectResult) UnmarshalJSON(data []byte) error {
+	var raw struct {
+		SnakeCase json.RawMessage `json:"language_info"`
+		CamelCase json.RawMessage `json:"languageInfo"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	candidates := bytes.TrimSpace(raw.SnakeCase)
+	if len(candidates) == 0 || bytes.Equal(candidates, []byte("null")) {
+		candidates = bytes.TrimSpace(raw.CamelCase)
+	}
+
+	dr.LanguageInfo = nil
+	switch {
+	case len(candidates) == 0 || bytes.Equal(candidates, []byte("null")):
+		return nil
+	case candidates[0] == '{':
+		var info LanguageInfo
+		if err := json.Unmarshal(candidates, &info); err != nil {
+			return err
+		}
+		dr.LanguageInfo = []LanguageInfo{info}
+		return nil
+	default:
+		return json.Unmarshal(candidates, &dr.LanguageInfo)
+	}
+}
+
+// Len returns the number of detected language candidates.
+func (dr DetectResult) Len() int { return len(dr.LanguageInfo) }
+
+// Candidates returns the detected languages with a confidence of at least @min, in the order of the response.
+func (dr DetectResult) Candidates(min float64) (candidates []LanguageInfo) {
+	for _, info := range dr.LanguageInfo {
+		if min <= info.Confidence {
+			candidates = append(candidates, info)
+		}
+	}
+	return
+}
+
 // String implements fmt.Stringer.
 func (dr DetectResult) String() string { return common.String(dr) }
 
@@ -76,9 +125,15 @@ func (di *DetectInitializer) AuthorizeWith(key string) *DetectInitializer {
 }
 
 // Collect returns the language detection result.
-func (di *DetectInitializer) Collect() (res DetectResult, err error) {
-	client := &http.Client{}
-	req, err := http.NewRequest(http.MethodGet,
+func (di *DetectInitializer) Collect() (DetectResult, error) {
+	return di.CollectContext(context.Background())
+}
+
+// CollectContext is like Collect, but sends the request with @ctx.
+func (di *DetectInitializer) CollectContext(ctx context.Context) (res DetectResult, err error) {
+	defer common.WrapError(OpDetect, &err)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet,
 		fmt.Sprintf("%s/v3/translation/language/detect?query=%s", prefix, di.Query), nil)
 	if err != nil {
 		return res, err
@@ -87,7 +142,7 @@ func (di *DetectInitializer) Collect() (res DetectResult, err error) {
 	req.Close = true
 	req.Header.Set(common.Authorization, di.Authkey)
 
-	resp, err := client.Do(req)
+	resp, err := common.Do(Service, req)
 	if err != nil {
 		return res, err
 	}
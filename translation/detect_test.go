@@ -18,6 +18,7 @@ import (
 	"internal/common"
 	"testing"
 
+	"github.com/goccy/go-json"
 	"github.com/maengsanha/kakao-developers-client/translation"
 )
 
@@ -44,3 +45,55 @@ func TestDetectWithSaveAsJSON(t *testing.T) {
 		t.Error(err)
 	}
 }
+
+func TestDetectResultUnmarshal(t *testing.T) {
+	want := []translation.LanguageInfo{
+		{Code: "en", Name: "English", Confidence: 0.84},
+		{Code: "kr", Name: "Korean", Confidence: 0.12},
+		{Code: "jp", Name: "Japanese", Confidence: 0.04},
+	}
+
+	for _, fixture := range []string{
+		`{"language_info":[
+			{"code":"en","name":"English","confidence":0.84},
+			{"code":"kr","name":"Korean","confidence":0.12},
+			{"code":"jp","name":"Japanese","confidence":0.04}]}`,
+		`{"languageInfo":[
+			{"code":"en","name":"English","confidence":0.84},
+			{"code":"kr","name":"Korean","confidence":0.12},
+			{"code":"jp","name":"Japanese","confidence":0.04}]}`,
+	} {
+		var dr translation.DetectResult
+		if err := json.Unmarshal([]byte(fixture), &dr); err != nil {
+			t.Fatal(err)
+		}
+		if dr.Len() != len(want) {
+			t.Fatalf("got %d candidates, want %d", dr.Len(), len(want))
+		}
+		for idx, info := range dr.LanguageInfo {
+			if info != want[idx] {
+				t.Errorf("candidate %d is %+v, want %+v", idx, info, want[idx])
+			}
+		}
+		if candidates := dr.Candidates(0.1); len(candidates) != 2 || candidates[0] != want[0] || candidates[1] != want[1] {
+			t.Errorf("got candidates %+v above 0.1, want %+v", candidates, want[:2])
+		}
+	}
+}
+
+func TestDetectResultUnmarshalSingleCandidate(t *testing.T) {
+	var dr translation.DetectResult
+	if err := json.Unmarshal([]byte(`{"languageInfo":{"code":"kr","name":"Korean","confidence":1.0}}`), &dr); err != nil {
+		t.Fatal(err)
+	}
+	if want := (translation.LanguageInfo{Code: "kr", Name: "Korean", Confidence: 1.0}); dr.Len() != 1 || dr.LanguageInfo[0] != want {
+		t.Errorf("got %+v, want a single %+v", dr.LanguageInfo, want)
+	}
+
+	if err := json.Unmarshal([]byte(`{}`), &dr); err != nil {
+		t.Fatal(err)
+	}
+	if dr.Len() != 0 || dr.Candidates(0) != nil {
+		t.Errorf("got %+v, want no candidates", dr.LanguageInfo)
+	}
+}
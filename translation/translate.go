@@ -15,6 +15,7 @@
 package translation
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"internal/common"
@@ -73,6 +74,17 @@ func (ti *TranslateInitializer) AuthorizeWith(key string) *TranslateInitializer
 	return ti
 }
 
+// Supports reports whether @lang is a language code which From and To accept.
+func Supports(lang string) bool {
+	switch lang {
+	case "kr", "en", "jp", "cn", "vi", "id", "ar", "bn", "de",
+		"es", "fr", "hi", "it", "ms", "nl", "pt", "ru", "th", "tr":
+		return true
+	default:
+		return false
+	}
+}
+
 // From sets the source language that input text to be translated.
 //
 // There are a few available source languages:
@@ -115,11 +127,9 @@ func (ti *TranslateInitializer) AuthorizeWith(key string) *TranslateInitializer
 //
 // tr: Turkish
 func (ti *TranslateInitializer) From(src string) *TranslateInitializer {
-	switch src {
-	case "kr", "en", "jp", "cn", "vi", "id", "ar", "bn", "de",
-		"es", "fr", "hi", "it", "ms", "nl", "pt", "ru", "th", "tr":
+	if Supports(src) {
 		ti.SrcLang = src
-	default:
+	} else {
 		panic(errors.New(
 			`source language must be one of the following options:
 			kr, en, jp, cn, vi, id, ar, bn, de, es, fr, hi, it, ms, nl, pt, ru, th, tr`))
@@ -172,11 +182,9 @@ func (ti *TranslateInitializer) From(src string) *TranslateInitializer {
 //
 // tr: Turkish
 func (ti *TranslateInitializer) To(target string) *TranslateInitializer {
-	switch target {
-	case "kr", "en", "jp", "cn", "vi", "id", "ar", "bn", "de",
-		"es", "fr", "hi", "it", "ms", "nl", "pt", "ru", "th", "tr":
+	if Supports(target) {
 		ti.TargetLang = target
-	default:
+	} else {
 		panic(errors.New(`target language must be one of the following options:
 		kr, en, jp, cn, vi, id, ar, bn, de, es, fr, hi, it, ms, nl, pt, ru, th, tr`))
 	}
@@ -187,9 +195,15 @@ func (ti *TranslateInitializer) To(target string) *TranslateInitializer {
 }
 
 // Collect returns the translation result.
-func (ti *TranslateInitializer) Collect() (res TranslateResult, err error) {
-	client := &http.Client{}
-	req, err := http.NewRequest(http.MethodGet,
+func (ti *TranslateInitializer) Collect() (TranslateResult, error) {
+	return ti.CollectContext(context.Background())
+}
+
+// CollectContext is like Collect, but sends the request with @ctx.
+func (ti *TranslateInitializer) CollectContext(ctx context.Context) (res TranslateResult, err error) {
+	defer common.WrapError(OpTranslate, &err)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet,
 		fmt.Sprintf("%s/v2/translation/translate?src_lang=%s&target_lang=%s&query=%s",
 			prefix, ti.SrcLang, ti.TargetLang, ti.Query), nil)
 	if err != nil {
@@ -199,7 +213,7 @@ func (ti *TranslateInitializer) Collect() (res TranslateResult, err error) {
 	req.Close = true
 	req.Header.Set(common.Authorization, ti.AuthKey)
 
-	resp, err := client.Do(req)
+	resp, err := common.Do(Service, req)
 	if err != nil {
 		return
 	}
@@ -15,9 +15,15 @@
 package translation_test
 
 import (
+	"context"
+	"errors"
+	"fmt"
 	"internal/common"
+	"net/http"
+	"strings"
 	"testing"
 
+	"github.com/maengsanha/kakao-developers-client/internal/mock"
 	"github.com/maengsanha/kakao-developers-client/translation"
 )
 
@@ -48,3 +54,15 @@ func TestTranslateWithSaveAsJSON(t *testing.T) {
 		t.Log(tr)
 	}
 }
+
+func TestTranslateErrorWrapping(t *testing.T) {
+	mock.Kakao(t, func(w http.ResponseWriter, r *http.Request) { fmt.Fprint(w, `{"translated_text":[]}`) })
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := translation.Translate("안녕하세요").From("kr").To("en").CollectContext(ctx)
+	if !errors.Is(err, context.Canceled) || !strings.HasPrefix(err.Error(), translation.OpTranslate+": ") {
+		t.Errorf("got %v, want %v prefixed with %s", err, context.Canceled, translation.OpTranslate)
+	}
+}
@@ -99,6 +99,8 @@ func (ai *AdultImageDetectInitializer) AuthorizeWith(key string) *AdultImageDete
 
 // Collect returns the adult image detection result.
 func (ai *AdultImageDetectInitializer) Collect() (res AdultImageDetectResult, err error) {
+	defer common.WrapError(OpAdultImageDetect, &err)
+
 	var req *http.Request
 
 	if ai.withFile {
@@ -145,8 +147,7 @@ func (ai *AdultImageDetectInitializer) Collect() (res AdultImageDetectResult, er
 	req.Close = true
 	req.Header.Add(common.Authorization, ai.AuthKey)
 
-	client := &http.Client{}
-	resp, err := client.Do(req)
+	resp, err := common.Do(Service, req)
 	if err != nil {
 		return
 	}
@@ -16,7 +16,6 @@ package vision
 
 import (
 	"bytes"
-	"errors"
 	"fmt"
 	"internal/common"
 	"io"
@@ -143,7 +142,7 @@ func (fi *FaceDetectInitializer) ThresholdAt(val float64) *FaceDetectInitializer
 	if 0.1 <= val && val <= 1.0 {
 		fi.Threshold = val
 	} else {
-		panic(errors.New("threshold must be between 0.1 and 1.0"))
+		panic(ErrThresholdOutOfBound)
 	}
 	if r := recover(); r != nil {
 		log.Panicln(r)
@@ -153,6 +152,8 @@ func (fi *FaceDetectInitializer) ThresholdAt(val float64) *FaceDetectInitializer
 
 // Collect returns the face detection result.
 func (fi *FaceDetectInitializer) Collect() (res FaceDetectResult, err error) {
+	defer common.WrapError(OpFaceDetect, &err)
+
 	var req *http.Request
 
 	if fi.withFile {
@@ -169,26 +170,14 @@ func (fi *FaceDetectInitializer) Collect() (res FaceDetectResult, err error) {
 
 		defer file.Close()
 
-		body := &bytes.Buffer{}
-		writer := multipart.NewWriter(body)
-		writer.WriteField("threshold", fmt.Sprintf("%f", fi.Threshold))
-
-		part, err := writer.CreateFormFile("image", fi.Filename)
+		image, err := io.ReadAll(file)
 		if err != nil {
 			return res, err
 		}
 
-		_, err = io.Copy(part, file)
-		if err != nil {
+		if req, err = newFaceDetectRequest(fi.Filename, image, fi.Threshold); err != nil {
 			return res, err
 		}
-		writer.Close()
-
-		req, err = http.NewRequest(http.MethodPost, fmt.Sprintf("%s/face/detect", prefix), body)
-		if err != nil {
-			return res, err
-		}
-		req.Header.Add("Content-Type", writer.FormDataContentType())
 
 	} else {
 		req, err = http.NewRequest(http.MethodPost, fmt.Sprintf("%s/face/detect?threshold=%f&image_url=%s", prefix, fi.Threshold, fi.ImageURL), nil)
@@ -200,8 +189,7 @@ func (fi *FaceDetectInitializer) Collect() (res FaceDetectResult, err error) {
 	req.Close = true
 	req.Header.Add(common.Authorization, fi.AuthKey)
 
-	client := &http.Client{}
-	resp, err := client.Do(req)
+	resp, err := common.Do(Service, req)
 	if err != nil {
 		return
 	}
@@ -214,3 +202,28 @@ func (fi *FaceDetectInitializer) Collect() (res FaceDetectResult, err error) {
 
 	return
 }
+
+// newFaceDetectRequest makes a face detection request which uploads @image read from @filename.
+func newFaceDetectRequest(filename string, image []byte, threshold float64) (*http.Request, error) {
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+	writer.WriteField("threshold", fmt.Sprintf("%f", threshold))
+
+	part, err := writer.CreateFormFile("image", filename)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err = part.Write(image); err != nil {
+		return nil, err
+	}
+	writer.Close()
+
+	req, err := http.NewRequest(http.MethodPost, fmt.Sprintf("%s/face/detect", prefix), body)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Add("Content-Type", writer.FormDataContentType())
+
+	return req, nil
+}
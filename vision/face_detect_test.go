@@ -15,9 +15,15 @@
 package vision_test
 
 import (
+	"errors"
 	"internal/common"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 
+	"github.com/maengsanha/kakao-developers-client/internal/mock"
 	"github.com/maengsanha/kakao-developers-client/vision"
 )
 
@@ -74,3 +80,20 @@ func TestFaceDetectWithFileSaveAsJSON(t *testing.T) {
 		t.Error(fr)
 	}
 }
+
+func TestFaceDetectErrorWrapping(t *testing.T) {
+	mock.Kakao(t, func(w http.ResponseWriter, r *http.Request) { t.Error("sent a request for a too large file") })
+
+	filename := filepath.Join(t.TempDir(), "face.png")
+	if err := os.WriteFile(filename, nil, 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Truncate(filename, 2*1024*1024+1); err != nil {
+		t.Fatal(err)
+	}
+
+	_, err := vision.FaceDetect().WithFile(filename).Collect()
+	if !errors.Is(err, common.ErrTooLargeFile) || !strings.HasPrefix(err.Error(), vision.OpFaceDetect+": ") {
+		t.Errorf("got %v, want %v prefixed with %s", err, common.ErrTooLargeFile, vision.OpFaceDetect)
+	}
+}
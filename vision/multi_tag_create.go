@@ -96,6 +96,8 @@ func (mi *MultiTagCreateInitializer) AuthorizeWith(key string) *MultiTagCreateIn
 
 // Collect returns the Multi-tag creation result.
 func (mi *MultiTagCreateInitializer) Collect() (res MultiTagCreateResult, err error) {
+	defer common.WrapError(OpMultiTagCreate, &err)
+
 	var req *http.Request
 
 	if mi.withFile {
@@ -140,8 +142,7 @@ func (mi *MultiTagCreateInitializer) Collect() (res MultiTagCreateResult, err er
 	req.Close = true
 	req.Header.Add(common.Authorization, mi.AuthKey)
 
-	client := &http.Client{}
-	resp, err := client.Do(req)
+	resp, err := common.Do(Service, req)
 	if err != nil {
 		return
 	}
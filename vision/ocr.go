@@ -32,6 +32,7 @@ import (
 type OCRInitializer struct {
 	AuthKey  string
 	Filename string
+	lenient  bool
 }
 
 // Result represents a document of a Optical Character Recognition result.
@@ -79,8 +80,17 @@ func (oi *OCRInitializer) AuthorizeWith(key string) *OCRInitializer {
 	return oi
 }
 
+// Lenient makes Collect keep the recognized texts decoded before the response is truncated,
+// returning them along with a *TruncatedResponseError.
+func (oi *OCRInitializer) Lenient() *OCRInitializer {
+	oi.lenient = true
+	return oi
+}
+
 // Collect returns the OCR result.
 func (oi *OCRInitializer) Collect() (res OCRResult, err error) {
+	defer common.WrapError(OpOCR, &err)
+
 	file, err := os.Open(oi.Filename)
 	if err != nil {
 		return res, err
@@ -116,8 +126,27 @@ func (oi *OCRInitializer) Collect() (res OCRResult, err error) {
 	req.Header.Add(common.Authorization, oi.AuthKey)
 	req.Header.Add("Content-Type", writer.FormDataContentType())
 
-	client := &http.Client{}
-	resp, err := client.Do(req)
+	resp, err := common.Do(Service, req)
+	if err != nil {
+		return
+	}
+
+	defer resp.Body.Close()
+
+	if oi.lenient {
+		err = common.DecodeLenient(resp.Body, nil, map[string]func(func(interface{}) error) error{
+			"result": func(decode func(interface{}) error) error {
+				var result Result
+				if err := decode(&result); err != nil {
+					return err
+				}
+				res.Result = append(res.Result, result)
+				return nil
+			},
+		})
+		return
+	}
+
 	if err = json.NewDecoder(resp.Body).Decode(&res); err != nil {
 		return
 	}
@@ -15,9 +15,14 @@
 package vision_test
 
 import (
+	"errors"
+	"fmt"
 	"internal/common"
+	"net/http"
+	"strings"
 	"testing"
 
+	"github.com/maengsanha/kakao-developers-client/internal/mock"
 	"github.com/maengsanha/kakao-developers-client/vision"
 )
 
@@ -43,3 +48,52 @@ func TestOCRSaveAsJSON(t *testing.T) {
 		t.Log(err)
 	}
 }
+
+func TestOCRLenient(t *testing.T) {
+	var (
+		sb   strings.Builder
+		ends []int
+	)
+	sb.WriteString(`{"result":[`)
+	for idx := 0; idx < 5; idx++ {
+		if 0 < idx {
+			sb.WriteString(",")
+		}
+		fmt.Fprintf(&sb, `{"boxes":[[0,%d],[10,%d],[10,%d],[0,%d]],"recognition_words":["word%d"]}`, idx, idx, idx+5, idx+5, idx)
+		ends = append(ends, sb.Len())
+	}
+	sb.WriteString(`]}`)
+	body := sb.String()
+
+	filename := writeTestImage(t)
+
+	cut := len(body)
+	mock.Kakao(t, func(w http.ResponseWriter, r *http.Request) { fmt.Fprint(w, body[:cut]) })
+
+	if or, err := vision.OCR(filename).Lenient().Collect(); err != nil {
+		t.Fatal(err)
+	} else if len(or.Result) != 5 || or.Result[4].RecognitionWords[0] != "word4" {
+		t.Errorf("unexpected result of an untruncated response %+v", or)
+	}
+
+	for _, tc := range []struct {
+		cut, salvaged int
+	}{
+		{5, 0},
+		{ends[1] - 3, 1},
+		{ends[2], 3},
+		{len(body) - 1, 5},
+	} {
+		cut = tc.cut
+
+		or, err := vision.OCR(filename).Lenient().Collect()
+
+		var truncated *vision.TruncatedResponseError
+		if !errors.As(err, &truncated) || !errors.Is(err, vision.ErrTruncatedResponse) {
+			t.Errorf("cut at %d: got %v, want a truncated response error", cut, err)
+		}
+		if len(or.Result) != tc.salvaged {
+			t.Errorf("cut at %d: salvaged %d results, want %d", cut, len(or.Result), tc.salvaged)
+		}
+	}
+}
@@ -0,0 +1,29 @@
+// Copyright 2022 Sanha Maeng, Soyang Baek, Jinmyeong Kim
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 		http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vision
+
+// Operation names, which prefix the errors returned by each API call.
+const (
+	OpFaceDetect       = "vision.face_detect"
+	OpProductDetect    = "vision.product_detect"
+	OpAdultImageDetect = "vision.adult_image_detect"
+	OpThumbnailCreate  = "vision.thumbnail_create"
+	OpThumbnailDetect  = "vision.thumbnail_detect"
+	OpMultiTagCreate   = "vision.multi_tag_create"
+	OpOCR              = "vision.ocr"
+)
+
+// Service is the name of the circuit breaker shared by the operations, see common.SetCircuitBreaker.
+const Service = "vision"
@@ -16,7 +16,6 @@ package vision
 
 import (
 	"bytes"
-	"errors"
 	"fmt"
 	"internal/common"
 	"io"
@@ -114,7 +113,7 @@ func (pi *ProductDetectInitializer) ThresholdAt(val float64) *ProductDetectIniti
 	if 0.1 <= val && val <= 1.0 {
 		pi.Threshold = val
 	} else {
-		panic(errors.New("threshold must be between 0.1 and 1.0"))
+		panic(ErrThresholdOutOfBound)
 	}
 	if r := recover(); r != nil {
 		log.Panicln(r)
@@ -124,6 +123,8 @@ func (pi *ProductDetectInitializer) ThresholdAt(val float64) *ProductDetectIniti
 
 // Collect returns the product detection result.
 func (pi *ProductDetectInitializer) Collect() (res ProductDetectResult, err error) {
+	defer common.WrapError(OpProductDetect, &err)
+
 	var req *http.Request
 
 	if pi.withFile {
@@ -170,8 +171,7 @@ func (pi *ProductDetectInitializer) Collect() (res ProductDetectResult, err erro
 	req.Close = true
 	req.Header.Add(common.Authorization, pi.AuthKey)
 
-	client := &http.Client{}
-	resp, err := client.Do(req)
+	resp, err := common.Do(Service, req)
 	if err != nil {
 		return
 	}
@@ -0,0 +1,193 @@
+// Copyright 2022 Sanha Maeng, Soyang Baek, Jinmyeong Kim
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 		http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vision
+
+import (
+	"encoding/csv"
+	"internal/common"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/goccy/go-json"
+)
+
+// sweepConcurrency is the number of face detection requests a sweep sends at once.
+const sweepConcurrency = 2
+
+// SweepPoint represents the face detection outcome at a single threshold.
+//
+// The deltas are relative to the previous (lower) threshold of the sweep, and zero for the first one.
+type SweepPoint struct {
+	Threshold      float64 `json:"threshold"`
+	Faces          int     `json:"faces"`
+	MeanScore      float64 `json:"mean_score"`
+	DeltaFaces     int     `json:"delta_faces"`
+	DeltaMeanScore float64 `json:"delta_mean_score"`
+}
+
+// SweepReport represents the face detection outcomes of an image across thresholds.
+type SweepReport struct {
+	Filename string       `json:"filename"`
+	Points   []SweepPoint `json:"points"`
+}
+
+// String implements fmt.Stringer.
+func (sr SweepReport) String() string { return common.String(sr) }
+
+// SaveAs saves sr to @filename.
+func (sr SweepReport) SaveAs(filename string) error { return common.SaveAsJSON(sr, filename) }
+
+// SaveAsCSV saves the points of sr to @filename, one threshold per row.
+//
+// The rows keep the order of the points, and floats are written in their shortest form.
+//
+// @filename should end with .csv.
+func (sr SweepReport) SaveAsCSV(filename string) error {
+	if tokens := strings.Split(filename, "."); tokens[len(tokens)-1] != "csv" {
+		return common.ErrUnsupportedFormat
+	}
+
+	file, err := os.Create(filename)
+	if err != nil {
+		return err
+	}
+
+	defer file.Close()
+
+	writer := csv.NewWriter(file)
+	writer.Write([]string{"threshold", "faces", "mean_score", "delta_faces", "delta_mean_score"})
+	for _, point := range sr.Points {
+		writer.Write([]string{
+			strconv.FormatFloat(point.Threshold, 'f', -1, 64),
+			strconv.Itoa(point.Faces),
+			strconv.FormatFloat(point.MeanScore, 'f', -1, 64),
+			strconv.Itoa(point.DeltaFaces),
+			strconv.FormatFloat(point.DeltaMeanScore, 'f', -1, 64),
+		})
+	}
+	writer.Flush()
+
+	return writer.Error()
+}
+
+// ThresholdSweep detects faces in the image of @path at each of @thresholds,
+// to help choosing the threshold for a dataset.
+//
+// @thresholds should be sorted in ascending order, without duplicates, and each between 0.1 and 1.0.
+// The image is read once and at most two requests are sent at once.
+func ThresholdSweep(path string, thresholds []float64, authKey string) (report SweepReport, err error) {
+	if len(thresholds) == 0 {
+		return report, ErrNoThresholds
+	}
+	for idx, threshold := range thresholds {
+		if threshold < 0.1 || 1.0 < threshold {
+			return report, ErrThresholdOutOfBound
+		}
+		if 0 < idx && threshold < thresholds[idx-1] {
+			return report, ErrUnsortedThresholds
+		}
+		if 0 < idx && threshold == thresholds[idx-1] {
+			return report, ErrDuplicatedThresholds
+		}
+	}
+
+	switch format := strings.Split(path, "."); format[len(format)-1] {
+	case "jpg", "png":
+	default:
+		return report, common.ErrUnsupportedFormat
+	}
+
+	if stat, err := os.Stat(path); err != nil {
+		return report, err
+	} else if 2*1024*1024 < stat.Size() {
+		return report, common.ErrTooLargeFile
+	}
+
+	image, err := os.ReadFile(path)
+	if err != nil {
+		return
+	}
+
+	var (
+		key     = common.FormatKey(authKey)
+		results = make([]FaceDetectResult, len(thresholds))
+		errors  = make([]error, len(thresholds))
+		tokens  = make(chan struct{}, sweepConcurrency)
+		wg      sync.WaitGroup
+	)
+
+	for idx, threshold := range thresholds {
+		wg.Add(1)
+		go func(idx int, threshold float64) {
+			defer wg.Done()
+
+			tokens <- struct{}{}
+			defer func() { <-tokens }()
+
+			results[idx], errors[idx] = detectFacesAt(path, image, threshold, key)
+		}(idx, threshold)
+	}
+	wg.Wait()
+
+	for _, err := range errors {
+		if err != nil {
+			return report, err
+		}
+	}
+
+	report.Filename = path
+	for idx, result := range results {
+		point := SweepPoint{Threshold: thresholds[idx], Faces: len(result.Result.Faces)}
+		for _, face := range result.Result.Faces {
+			point.MeanScore += face.Score
+		}
+		if 0 < point.Faces {
+			point.MeanScore /= float64(point.Faces)
+		}
+		if 0 < idx {
+			prev := report.Points[idx-1]
+			point.DeltaFaces, point.DeltaMeanScore = point.Faces-prev.Faces, point.MeanScore-prev.MeanScore
+		}
+		report.Points = append(report.Points, point)
+	}
+
+	return
+}
+
+// detectFacesAt uploads @image read from @filename and detects faces in it at @threshold.
+func detectFacesAt(filename string, image []byte, threshold float64, key string) (res FaceDetectResult, err error) {
+	defer common.WrapError(OpFaceDetect, &err)
+
+	req, err := newFaceDetectRequest(filename, image, threshold)
+	if err != nil {
+		return
+	}
+
+	req.Close = true
+	req.Header.Add(common.Authorization, key)
+
+	resp, err := common.Do(Service, req)
+	if err != nil {
+		return
+	}
+
+	defer resp.Body.Close()
+
+	err = json.NewDecoder(resp.Body).Decode(&res)
+
+	return
+}
@@ -0,0 +1,151 @@
+// Copyright 2022 Sanha Maeng, Soyang Baek, Jinmyeong Kim
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 		http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vision_test
+
+import (
+	"fmt"
+	"math"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync/atomic"
+	"testing"
+
+	"github.com/maengsanha/kakao-developers-client/internal/mock"
+	"github.com/maengsanha/kakao-developers-client/vision"
+)
+
+const testRESTKey = "0123456789abcdef0123456789abcdef"
+
+// mockFaceScores are the scores of the faces in the mocked image.
+var mockFaceScores = []float64{0.95, 0.85, 0.75, 0.55, 0.35}
+
+func mockFaceDetect(t *testing.T) (inflight *int32) {
+	var current, max int32
+
+	mock.Kakao(t, func(w http.ResponseWriter, r *http.Request) {
+		if n := atomic.AddInt32(&current, 1); atomic.LoadInt32(&max) < n {
+			atomic.StoreInt32(&max, n)
+		}
+		defer atomic.AddInt32(&current, -1)
+
+		if _, _, err := r.FormFile("image"); err != nil {
+			t.Error(err)
+		}
+		threshold, err := strconv.ParseFloat(r.FormValue("threshold"), 64)
+		if err != nil {
+			t.Error(err)
+		}
+
+		res := vision.FaceDetectResult{}
+		for _, score := range mockFaceScores {
+			if threshold <= score {
+				res.Result.Faces = append(res.Result.Faces, vision.Face{Score: score})
+			}
+		}
+		fmt.Fprint(w, res)
+	})
+
+	return &max
+}
+
+func writeTestImage(t *testing.T) string {
+	filename := filepath.Join(t.TempDir(), "faces.jpg")
+	if err := os.WriteFile(filename, []byte("not really a jpeg"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	return filename
+}
+
+func TestThresholdSweep(t *testing.T) {
+	inflight := mockFaceDetect(t)
+	filename := writeTestImage(t)
+
+	report, err := vision.ThresholdSweep(filename, []float64{0.3, 0.5, 0.7, 0.8, 0.9}, testRESTKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []vision.SweepPoint{
+		{Threshold: 0.3, Faces: 5, MeanScore: 0.69},
+		{Threshold: 0.5, Faces: 4, MeanScore: 0.775, DeltaFaces: -1, DeltaMeanScore: 0.085},
+		{Threshold: 0.7, Faces: 3, MeanScore: 0.85, DeltaFaces: -1, DeltaMeanScore: 0.075},
+		{Threshold: 0.8, Faces: 2, MeanScore: 0.9, DeltaFaces: -1, DeltaMeanScore: 0.05},
+		{Threshold: 0.9, Faces: 1, MeanScore: 0.95, DeltaFaces: -1, DeltaMeanScore: 0.05},
+	}
+	if len(report.Points) != len(want) {
+		t.Fatalf("got %d points, want %d", len(report.Points), len(want))
+	}
+	for idx, point := range report.Points {
+		if point.Threshold != want[idx].Threshold || point.Faces != want[idx].Faces ||
+			point.DeltaFaces != want[idx].DeltaFaces ||
+			1e-9 < math.Abs(point.MeanScore-want[idx].MeanScore) ||
+			1e-9 < math.Abs(point.DeltaMeanScore-want[idx].DeltaMeanScore) {
+			t.Errorf("point %d is %+v, want %+v", idx, point, want[idx])
+		}
+	}
+
+	if 2 < *inflight {
+		t.Errorf("sent %d requests at once, want at most 2", *inflight)
+	}
+}
+
+func TestThresholdSweepSaveAsCSV(t *testing.T) {
+	report := vision.SweepReport{Points: []vision.SweepPoint{
+		{Threshold: 0.5, Faces: 2, MeanScore: 0.75},
+		{Threshold: 0.9, Faces: 1, MeanScore: 0.95, DeltaFaces: -1, DeltaMeanScore: 0.2},
+	}}
+
+	filename := filepath.Join(t.TempDir(), "sweep.csv")
+	if err := report.SaveAsCSV(filename); err != nil {
+		t.Fatal(err)
+	}
+
+	bs, err := os.ReadFile(filename)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := "threshold,faces,mean_score,delta_faces,delta_mean_score\n" +
+		"0.5,2,0.75,0,0\n" +
+		"0.9,1,0.95,-1,0.2\n"
+	if string(bs) != want {
+		t.Errorf("got\n%s\nwant\n%s", bs, want)
+	}
+
+	if err := report.SaveAsCSV(filepath.Join(t.TempDir(), "sweep.json")); err == nil {
+		t.Error("expected an error saving a CSV report as JSON")
+	}
+}
+
+func TestThresholdSweepValidation(t *testing.T) {
+	filename := writeTestImage(t)
+
+	for _, tc := range []struct {
+		thresholds []float64
+		err        error
+	}{
+		{nil, vision.ErrNoThresholds},
+		{[]float64{0.05, 0.5}, vision.ErrThresholdOutOfBound},
+		{[]float64{0.5, 1.1}, vision.ErrThresholdOutOfBound},
+		{[]float64{0.7, 0.5}, vision.ErrUnsortedThresholds},
+		{[]float64{0.5, 0.5, 0.7}, vision.ErrDuplicatedThresholds},
+	} {
+		if _, err := vision.ThresholdSweep(filename, tc.thresholds, testRESTKey); err != tc.err {
+			t.Errorf("sweeping %v: got %v, want %v", tc.thresholds, err, tc.err)
+		}
+	}
+}
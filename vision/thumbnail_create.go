@@ -105,6 +105,8 @@ func (ti *ThumbnailCreateInitializer) HeightTo(ratio int) *ThumbnailCreateInitia
 
 // Collect returns the thumbnail creation result.
 func (ti *ThumbnailCreateInitializer) Collect() (res ThumbnailCreateResult, err error) {
+	defer common.WrapError(OpThumbnailCreate, &err)
+
 	var req *http.Request
 
 	if ti.withFile {
@@ -154,8 +156,7 @@ func (ti *ThumbnailCreateInitializer) Collect() (res ThumbnailCreateResult, err
 	req.Close = true
 	req.Header.Add(common.Authorization, ti.AuthKey)
 
-	client := &http.Client{}
-	resp, err := client.Do(req)
+	resp, err := common.Do(Service, req)
 	if err != nil {
 		return res, err
 	}
@@ -121,6 +121,8 @@ func (ti *ThumbnailDetectInitializer) HeightTo(ratio int) *ThumbnailDetectInitia
 
 // Collect returns the thumbnail detection result.
 func (ti *ThumbnailDetectInitializer) Collect() (res ThumbnailDetectResult, err error) {
+	defer common.WrapError(OpThumbnailDetect, &err)
+
 	var req *http.Request
 
 	if ti.withFile {
@@ -171,8 +173,7 @@ func (ti *ThumbnailDetectInitializer) Collect() (res ThumbnailDetectResult, err
 	req.Close = true
 	req.Header.Add(common.Authorization, ti.AuthKey)
 
-	client := &http.Client{}
-	resp, err := client.Do(req)
+	resp, err := common.Do(Service, req)
 	if err != nil {
 		return
 	}